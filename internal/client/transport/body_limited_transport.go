@@ -0,0 +1,87 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transport
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+var _ http.RoundTripper = &BodyLimitedTransport{}
+
+// ResponseTooLargeError is returned when a response body is larger than the
+// limit of a BodyLimitedTransport.
+type ResponseTooLargeError struct {
+	Limit int64
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("response body exceeds the limit of %d bytes", e.Limit)
+}
+
+// BodyLimitedTransport fails responses whose body is larger than limit bytes,
+// so a huge body is never read into memory whole.
+type BodyLimitedTransport struct {
+	roundTripper http.RoundTripper
+	limit        int64
+}
+
+func (t *BodyLimitedTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	resp, err := t.roundTripper.RoundTrip(r)
+	if err != nil || resp == nil || resp.Body == nil {
+		return resp, err
+	}
+
+	if resp.ContentLength > t.limit {
+		resp.Body.Close()
+		return nil, &ResponseTooLargeError{Limit: t.limit}
+	}
+
+	resp.Body = &limitedBody{ReadCloser: resp.Body, limit: t.limit, remaining: t.limit}
+	return resp, nil
+}
+
+func NewBodyLimitedTransport(rt http.RoundTripper, limit int64) http.RoundTripper {
+	return &BodyLimitedTransport{
+		roundTripper: rt,
+		limit:        limit,
+	}
+}
+
+// limitedBody fails reads once more than its limit has been read.
+type limitedBody struct {
+	io.ReadCloser
+	limit     int64
+	remaining int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	// Read one byte past the limit to tell a body of exactly limit bytes
+	// from a larger one.
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+
+	n, err := b.ReadCloser.Read(p)
+	if int64(n) > b.remaining {
+		b.remaining = 0
+		return 0, &ResponseTooLargeError{Limit: b.limit}
+	}
+	b.remaining -= int64(n)
+	return n, err
+}
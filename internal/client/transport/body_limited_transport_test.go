@@ -0,0 +1,82 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transport_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/circlefin/terraform-provider-quicknode/internal/client/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+// sizedBodyServer responds with a body of size bytes, streamed without a
+// Content-Length when chunked is set, and counts its requests.
+func sizedBodyServer(t *testing.T, size int, chunked bool) (*httptest.Server, *int) {
+	t.Helper()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if chunked {
+			w.(http.Flusher).Flush()
+		}
+		io.WriteString(w, strings.Repeat("x", size))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func TestBodyLimitedTransport_OversizedResponse(t *testing.T) {
+	for _, chunked := range []bool{false, true} {
+		server, requests := sizedBodyServer(t, 2048, chunked)
+		client := transport.NewRetryableThrottledClient(100, nil, nil, nil, 1024, nil)
+
+		var tooLarge *transport.ResponseTooLargeError
+		resp, err := client.Get(server.URL)
+		if err == nil {
+			_, err = io.ReadAll(resp.Body)
+			resp.Body.Close()
+		}
+
+		assert.True(t, errors.As(err, &tooLarge), "chunked %t: expected a ResponseTooLargeError, got %v", chunked, err)
+		assert.ErrorContains(t, err, "exceeds the limit of 1024 bytes")
+		assert.Equal(t, 1, *requests, "chunked %t: oversized responses are not retried", chunked)
+	}
+}
+
+func TestBodyLimitedTransport_ResponseAtLimit(t *testing.T) {
+	for _, chunked := range []bool{false, true} {
+		server, _ := sizedBodyServer(t, 1024, chunked)
+		client := transport.NewRetryableThrottledClient(100, nil, nil, nil, 1024, nil)
+
+		resp, err := client.Get(server.URL)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		assert.NoError(t, err, "chunked %t", chunked)
+		assert.Len(t, body, 1024, "chunked %t", chunked)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transport
+
+import (
+	"io"
+	"net/http"
+	"sync"
+)
+
+var _ http.RoundTripper = &ConcurrencyLimitedTransport{}
+
+// ConcurrencyLimiter bounds the number of requests in flight. One limiter can
+// be shared by several transports to bound them together.
+type ConcurrencyLimiter struct {
+	slots chan struct{}
+}
+
+func NewConcurrencyLimiter(n int) *ConcurrencyLimiter {
+	return &ConcurrencyLimiter{slots: make(chan struct{}, n)}
+}
+
+// ConcurrencyLimitedTransport holds a slot of its limiter from the start of a
+// request until its response body is closed.
+type ConcurrencyLimitedTransport struct {
+	roundTripper http.RoundTripper
+	limiter      *ConcurrencyLimiter
+}
+
+func (c *ConcurrencyLimitedTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	select {
+	case c.limiter.slots <- struct{}{}:
+	case <-r.Context().Done():
+		return nil, r.Context().Err()
+	}
+
+	var once sync.Once
+	release := func() { once.Do(func() { <-c.limiter.slots }) }
+
+	resp, err := c.roundTripper.RoundTrip(r)
+	if err != nil || resp == nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+func NewConcurrencyLimitedTransport(rt http.RoundTripper, limiter *ConcurrencyLimiter) http.RoundTripper {
+	return &ConcurrencyLimitedTransport{
+		roundTripper: rt,
+		limiter:      limiter,
+	}
+}
+
+// releasingBody frees a limiter slot once the response body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transport_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/circlefin/terraform-provider-quicknode/internal/client/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+// inFlightRoundTripper records the most requests it has served at once.
+type inFlightRoundTripper struct {
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (rt *inFlightRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	n := rt.inFlight.Add(1)
+	defer rt.inFlight.Add(-1)
+
+	for {
+		peak := rt.maxInFlight.Load()
+		if n <= peak || rt.maxInFlight.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	return &http.Response{Body: io.NopCloser(strings.NewReader("ok"))}, nil
+}
+
+func TestConcurrencyLimitedTransport(t *testing.T) {
+	rt := &inFlightRoundTripper{}
+	limited := transport.NewConcurrencyLimitedTransport(rt, transport.NewConcurrencyLimiter(3))
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, "https://api.quicknode.com/v0/chains", nil)
+			resp, err := limited.RoundTrip(req)
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, rt.maxInFlight.Load(), int32(3))
+}
+
+func TestConcurrencyLimitedTransport_HoldsSlotUntilBodyClosed(t *testing.T) {
+	limited := transport.NewConcurrencyLimitedTransport(&inFlightRoundTripper{}, transport.NewConcurrencyLimiter(1))
+
+	first, err := limited.RoundTrip(&http.Request{})
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.quicknode.com/v0/chains", nil)
+	_, err = limited.RoundTrip(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	assert.NoError(t, first.Body.Close())
+	// Closing twice must not free a second slot.
+	assert.NoError(t, first.Body.Close())
+
+	_, err = limited.RoundTrip(&http.Request{})
+	assert.NoError(t, err)
+}
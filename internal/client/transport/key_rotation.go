@@ -0,0 +1,136 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transport
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var _ http.RoundTripper = &KeyRotatingTransport{}
+
+// keyCooldownDefault is how long a rate limited key is skipped when the
+// response does not say when to retry.
+const keyCooldownDefault = time.Minute
+
+// KeyRotation authenticates requests with one of several API keys, moving on
+// to the next key while one is rate limited.
+type KeyRotation struct {
+	setKey func(r *http.Request, key string)
+	now    func() time.Time
+
+	mu            sync.Mutex
+	keys          []string
+	cooldownUntil []time.Time
+	current       int
+}
+
+// NewKeyRotation returns a KeyRotation over keys, which authenticates a
+// request with setKey.
+func NewKeyRotation(keys []string, setKey func(r *http.Request, key string)) *KeyRotation {
+	return &KeyRotation{
+		setKey:        setKey,
+		now:           time.Now,
+		keys:          keys,
+		cooldownUntil: make([]time.Time, len(keys)),
+	}
+}
+
+// next returns the first key from the current one that is not cooling down,
+// or the current key and false if they all are.
+func (k *KeyRotation) next() (int, bool) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	now := k.now()
+	for i := range k.keys {
+		candidate := (k.current + i) % len(k.keys)
+		if !now.Before(k.cooldownUntil[candidate]) {
+			k.current = candidate
+			return candidate, true
+		}
+	}
+	return k.current, false
+}
+
+func (k *KeyRotation) coolDown(i int, d time.Duration) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.cooldownUntil[i] = k.now().Add(d)
+}
+
+// KeyRotatingTransport sends each request with a key of its KeyRotation. A
+// request rejected with 429 is sent again at once with the next key that is
+// not cooling down; once every key is, the 429 is returned.
+type KeyRotatingTransport struct {
+	roundTripper http.RoundTripper
+	keys         *KeyRotation
+}
+
+func (t *KeyRotatingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	i, _ := t.keys.next()
+
+	for attempt := 1; ; attempt++ {
+		req := r.Clone(r.Context())
+		if attempt > 1 && r.GetBody != nil {
+			body, err := r.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		t.keys.setKey(req, t.keys.keys[i])
+
+		resp, err := t.roundTripper.RoundTrip(req)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests {
+			return resp, err
+		}
+
+		t.keys.coolDown(i, retryAfter(resp))
+
+		// Resend with the next key unless every key is cooling down or the
+		// body cannot be read again.
+		next, ok := t.keys.next()
+		if !ok || attempt == len(t.keys.keys) || (r.Body != nil && r.GetBody == nil) {
+			return resp, nil
+		}
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		i = next
+	}
+}
+
+func NewKeyRotatingTransport(rt http.RoundTripper, keys *KeyRotation) http.RoundTripper {
+	return &KeyRotatingTransport{
+		roundTripper: rt,
+		keys:         keys,
+	}
+}
+
+// retryAfter returns the delay in a response's Retry-After header given in
+// seconds, or keyCooldownDefault.
+func retryAfter(resp *http.Response) time.Duration {
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return keyCooldownDefault
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transport_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/circlefin/terraform-provider-quicknode/internal/client/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+func setTestKey(r *http.Request, key string) {
+	r.Header.Set("x-api-key", key)
+}
+
+// keyLimitedServer rejects requests made with any of limited keys with 429
+// and records the key and body of every request.
+func keyLimitedServer(t *testing.T, limited ...string) (*httptest.Server, *[]string) {
+	t.Helper()
+
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("x-api-key")
+		body, _ := io.ReadAll(r.Body)
+		seen = append(seen, key+":"+string(body))
+
+		for _, l := range limited {
+			if key == l {
+				w.Header().Set("Retry-After", "60")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &seen
+}
+
+func TestKeyRotation_FailsOverToNextKey(t *testing.T) {
+	server, seen := keyLimitedServer(t, "first")
+	keys := transport.NewKeyRotation([]string{"first", "second"}, setTestKey)
+	client := transport.NewRetryableThrottledClient(100, nil, nil, keys, 0, nil)
+
+	for range 2 {
+		resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"stream"}`))
+		if assert.NoError(t, err) {
+			resp.Body.Close()
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		}
+	}
+
+	// The first key is skipped while it cools down, and the body is resent.
+	assert.Equal(t, []string{`first:{"name":"stream"}`, `second:{"name":"stream"}`, `second:{"name":"stream"}`}, *seen)
+}
+
+func TestKeyRotation_AllKeysLimited(t *testing.T) {
+	server, seen := keyLimitedServer(t, "first", "second")
+	keys := transport.NewKeyRotation([]string{"first", "second"}, setTestKey)
+	rt := transport.NewKeyRotatingTransport(http.DefaultTransport, keys)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := rt.RoundTrip(req)
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	}
+	assert.Equal(t, []string{"first:", "second:"}, *seen)
+}
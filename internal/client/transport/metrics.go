@@ -0,0 +1,66 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transport
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+type requestMetricsKey struct{}
+
+// RequestMetrics counts the retries and rate limit waits of the requests made
+// with a context returned by WithRequestMetrics.
+type RequestMetrics struct {
+	retries      atomic.Int64
+	throttleWait atomic.Int64
+}
+
+// WithRequestMetrics returns a context whose requests are counted in the
+// returned RequestMetrics.
+func WithRequestMetrics(ctx context.Context) (context.Context, *RequestMetrics) {
+	m := &RequestMetrics{}
+	return context.WithValue(ctx, requestMetricsKey{}, m), m
+}
+
+// Retries returns the number of requests retried.
+func (m *RequestMetrics) Retries() int64 {
+	return m.retries.Load()
+}
+
+// ThrottleWait returns the total time requests waited on the rate limiter.
+func (m *RequestMetrics) ThrottleWait() time.Duration {
+	return time.Duration(m.throttleWait.Load())
+}
+
+func requestMetricsFromContext(ctx context.Context) *RequestMetrics {
+	m, _ := ctx.Value(requestMetricsKey{}).(*RequestMetrics)
+	return m
+}
+
+func recordRetry(ctx context.Context) {
+	if m := requestMetricsFromContext(ctx); m != nil {
+		m.retries.Add(1)
+	}
+}
+
+func recordThrottleWait(ctx context.Context, wait time.Duration) {
+	if m := requestMetricsFromContext(ctx); m != nil {
+		m.throttleWait.Add(int64(wait))
+	}
+}
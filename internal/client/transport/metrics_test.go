@@ -0,0 +1,72 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transport_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/circlefin/terraform-provider-quicknode/internal/client/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestMetrics_CountsRetries(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests <= 2 {
+			// Retry-After keeps the retry backoff out of the test's runtime.
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := transport.NewRetryableThrottledClient(100, nil, nil, nil, 0, nil)
+	ctx, metrics := transport.WithRequestMetrics(context.Background())
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	resp, err := client.Do(req)
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	assert.Equal(t, int64(2), metrics.Retries())
+}
+
+func TestRequestMetrics_IgnoresOtherContexts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := transport.NewRetryableThrottledClient(100, nil, nil, nil, 0, nil)
+	_, metrics := transport.WithRequestMetrics(context.Background())
+
+	resp, err := client.Get(server.URL)
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+	}
+
+	assert.Equal(t, int64(0), metrics.Retries())
+	assert.Zero(t, metrics.ThrottleWait())
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenExpiryDelta is how long before its expiry a cached token is refreshed,
+// so a request never goes out with a token that expires in flight.
+const tokenExpiryDelta = 30 * time.Second
+
+// ClientCredentials fetches bearer tokens with the OAuth2 client credentials
+// grant and caches them until shortly before they expire.
+type ClientCredentials struct {
+	clientID     string
+	clientSecret string
+	tokenURL     string
+	client       *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+func NewClientCredentials(clientID, clientSecret, tokenURL string, client *http.Client) *ClientCredentials {
+	return &ClientCredentials{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		tokenURL:     tokenURL,
+		client:       client,
+	}
+}
+
+// Intercept sets the Authorization header of req to a current bearer token. It
+// has the signature of a generated client RequestEditorFn.
+func (c *ClientCredentials) Intercept(ctx context.Context, req *http.Request) error {
+	token, err := c.Token(ctx)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// Token returns the cached token, fetching a new one if there is none or it is
+// about to expire. Tokens issued without expires_in are cached indefinitely.
+func (c *ClientCredentials) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && (c.expiry.IsZero() || time.Now().Before(c.expiry)) {
+		return c.token, nil
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("creating token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(c.clientID), url.QueryEscape(c.clientSecret))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("requesting token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token endpoint returned %s", resp.Status)
+	}
+
+	var body tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("decoding token response: %w", err)
+	}
+	if body.AccessToken == "" {
+		return "", fmt.Errorf("token response has no access_token")
+	}
+
+	c.token = body.AccessToken
+	c.expiry = time.Time{}
+	if body.ExpiresIn > 0 {
+		c.expiry = time.Now().Add(time.Duration(body.ExpiresIn)*time.Second - tokenExpiryDelta)
+	}
+
+	return c.token, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transport_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/circlefin/terraform-provider-quicknode/internal/client/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTokenServer returns a stub token endpoint issuing token-1, token-2, ...
+// that expire after expiresIn seconds.
+func newTokenServer(t *testing.T, expiresIn int, requests *int) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+
+		id, secret, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "client-id", id)
+		assert.Equal(t, "client-secret", secret)
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":%d}`, *requests, expiresIn)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestClientCredentials_CachesToken(t *testing.T) {
+	var requests int
+	server := newTokenServer(t, 3600, &requests)
+	cc := transport.NewClientCredentials("client-id", "client-secret", server.URL, server.Client())
+
+	for range 3 {
+		token, err := cc.Token(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "token-1", token)
+	}
+	assert.Equal(t, 1, requests)
+}
+
+func TestClientCredentials_RefreshesBeforeExpiry(t *testing.T) {
+	var requests int
+	// Tokens expiring within the refresh window are never reused.
+	server := newTokenServer(t, 1, &requests)
+	cc := transport.NewClientCredentials("client-id", "client-secret", server.URL, server.Client())
+
+	first, err := cc.Token(context.Background())
+	assert.NoError(t, err)
+	second, err := cc.Token(context.Background())
+	assert.NoError(t, err)
+
+	assert.Equal(t, "token-1", first)
+	assert.Equal(t, "token-2", second)
+	assert.Equal(t, 2, requests)
+}
+
+func TestClientCredentials_Intercept(t *testing.T) {
+	var requests int
+	server := newTokenServer(t, 3600, &requests)
+	cc := transport.NewClientCredentials("client-id", "client-secret", server.URL, server.Client())
+
+	req := httptest.NewRequest(http.MethodGet, "https://api.quicknode.com/v0/chains", nil)
+	assert.NoError(t, cc.Intercept(context.Background(), req))
+	assert.Equal(t, "Bearer token-1", req.Header.Get("Authorization"))
+}
+
+func TestClientCredentials_TokenEndpointError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+	cc := transport.NewClientCredentials("client-id", "client-secret", server.URL, server.Client())
+
+	_, err := cc.Token(context.Background())
+	assert.ErrorContains(t, err, "401")
+}
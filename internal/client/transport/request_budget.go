@@ -0,0 +1,72 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transport
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+)
+
+var _ http.RoundTripper = &BudgetedTransport{}
+
+// BudgetExceededError is returned for requests made once a RequestBudget is
+// spent.
+type BudgetExceededError struct {
+	Limit int64
+}
+
+func (e *BudgetExceededError) Error() string {
+	return fmt.Sprintf("request budget of %d API calls exceeded", e.Limit)
+}
+
+// RequestBudget caps the number of requests made. One budget can be shared by
+// several transports to cap them together.
+type RequestBudget struct {
+	limit int64
+	used  atomic.Int64
+}
+
+func NewRequestBudget(limit int64) *RequestBudget {
+	return &RequestBudget{limit: limit}
+}
+
+// spend takes one request from the budget, reporting whether any was left.
+func (b *RequestBudget) spend() bool {
+	return b.used.Add(1) <= b.limit
+}
+
+// BudgetedTransport fails requests with a BudgetExceededError once its budget
+// is spent, without sending them.
+type BudgetedTransport struct {
+	roundTripper http.RoundTripper
+	budget       *RequestBudget
+}
+
+func (t *BudgetedTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if !t.budget.spend() {
+		return nil, &BudgetExceededError{Limit: t.budget.limit}
+	}
+	return t.roundTripper.RoundTrip(r)
+}
+
+func NewBudgetedTransport(rt http.RoundTripper, budget *RequestBudget) http.RoundTripper {
+	return &BudgetedTransport{
+		roundTripper: rt,
+		budget:       budget,
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transport_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/circlefin/terraform-provider-quicknode/internal/client/transport"
+	"github.com/circlefin/terraform-provider-quicknode/internal/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingServer answers every request with status, counting them.
+func countingServer(t *testing.T, status int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func TestRequestBudget_StopsRequests(t *testing.T) {
+	server, requests := countingServer(t, http.StatusOK)
+
+	// One budget is shared by the QuickNode and Streams clients.
+	budget := transport.NewRequestBudget(3)
+	first := transport.NewRetryableThrottledClient(100, nil, nil, nil, 0, budget)
+	second := transport.NewRetryableThrottledClient(100, nil, nil, nil, 0, budget)
+
+	for _, client := range []*http.Client{first, second, first} {
+		resp, err := client.Get(server.URL)
+		if assert.NoError(t, err) {
+			resp.Body.Close()
+		}
+	}
+
+	for _, client := range []*http.Client{first, second} {
+		_, err := client.Get(server.URL)
+
+		var budgetExceeded *transport.BudgetExceededError
+		if assert.True(t, errors.As(err, &budgetExceeded), "expected a BudgetExceededError, got %v", err) {
+			assert.Equal(t, int64(3), budgetExceeded.Limit)
+		}
+		assert.Contains(t, utils.BuildClientErrorMessage(err), "max_api_calls")
+	}
+
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestRequestBudget_CountsRetries(t *testing.T) {
+	server, requests := countingServer(t, http.StatusServiceUnavailable)
+
+	client := transport.NewRetryableThrottledClient(100, nil, nil, nil, 0, transport.NewRequestBudget(2))
+	_, err := client.Get(server.URL)
+
+	var budgetExceeded *transport.BudgetExceededError
+	assert.True(t, errors.As(err, &budgetExceeded), "expected a BudgetExceededError, got %v", err)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestRequestBudget_CountsKeyRotation(t *testing.T) {
+	server, seen := keyLimitedServer(t, "first")
+	keys := transport.NewKeyRotation([]string{"first", "second"}, setTestKey)
+
+	client := transport.NewRetryableThrottledClient(100, nil, nil, keys, 0, transport.NewRequestBudget(1))
+	_, err := client.Get(server.URL)
+
+	// The resend with the second key is a second request and is refused.
+	var budgetExceeded *transport.BudgetExceededError
+	assert.True(t, errors.As(err, &budgetExceeded), "expected a BudgetExceededError, got %v", err)
+	assert.Equal(t, []string{"first:"}, *seen)
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// RetryPolicy is the retryablehttp.CheckRetry used by the provider's clients.
+// It defers to retryablehttp.DefaultRetryPolicy, which already retries
+// transport failures such as timeouts, temporary DNS failures and connection
+// resets, but does not retry failures that cannot go away on their own.
+func RetryPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	// Never retry once the caller has given up.
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	// A host that does not resolve will not resolve next time either.
+	if isPermanentDNSError(err) {
+		return false, nil
+	}
+
+	// A response over the size limit will be just as large next time.
+	var tooLarge *ResponseTooLargeError
+	if errors.As(err, &tooLarge) {
+		return false, nil
+	}
+
+	// Retrying would only spend more of a budget that is already gone.
+	var budgetExceeded *BudgetExceededError
+	if errors.As(err, &budgetExceeded) {
+		return false, nil
+	}
+
+	return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+}
+
+// RetryPolicyWithStatuses extends RetryPolicy to also retry responses with
+// any of statuses.
+func RetryPolicyWithStatuses(statuses []int) retryablehttp.CheckRetry {
+	if len(statuses) == 0 {
+		return RetryPolicy
+	}
+
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		retry, err := RetryPolicy(ctx, resp, err)
+		if retry || err != nil || resp == nil {
+			return retry, err
+		}
+
+		return slices.Contains(statuses, resp.StatusCode), nil
+	}
+}
+
+// RateLimitedError is returned when every attempt of a request was rate
+// limited with a 429 response.
+type RateLimitedError struct {
+	Method   string
+	URL      string
+	Attempts int
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("%s %s rate limited with status 429, giving up after %d attempt(s)", e.Method, e.URL, e.Attempts)
+}
+
+// retriesExhausted is the retryablehttp.ErrorHandler used by the provider's
+// clients. It reports a request still rate limited after its last attempt as
+// a RateLimitedError, and otherwise fails like retryablehttp does by default.
+func retriesExhausted(resp *http.Response, err error, attempts int) (*http.Response, error) {
+	// Name the request like retryablehttp does. Without a response, err is
+	// the *url.Error of the last attempt, which names it already.
+	var method, url, request string
+	if resp != nil {
+		defer resp.Body.Close()
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+		if resp.Request != nil {
+			method, url = resp.Request.Method, resp.Request.URL.Redacted()
+			request = method + " " + url + " "
+		}
+	}
+
+	if err == nil && resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		return nil, &RateLimitedError{Method: method, URL: url, Attempts: attempts}
+	}
+
+	if err == nil {
+		return nil, fmt.Errorf("%sgiving up after %d attempt(s)", request, attempts)
+	}
+	return nil, fmt.Errorf("%sgiving up after %d attempt(s): %w", request, attempts, err)
+}
+
+// isPermanentDNSError reports whether err is a DNS failure that is neither
+// temporary nor a timeout, such as a host that does not exist.
+func isPermanentDNSError(err error) bool {
+	var dnsErr *net.DNSError
+	if !errors.As(err, &dnsErr) {
+		return false
+	}
+	return !dnsErr.IsTemporary && !dnsErr.IsTimeout
+}
@@ -0,0 +1,170 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transport_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/circlefin/terraform-provider-quicknode/internal/client/transport"
+	"github.com/circlefin/terraform-provider-quicknode/internal/utils"
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+)
+
+// FlakyRoundTripper fails the first request with a connection reset and
+// succeeds afterwards.
+type FlakyRoundTripper struct {
+	attempts int
+}
+
+func (rt *FlakyRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	rt.attempts++
+	if rt.attempts == 1 {
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+}
+
+func TestRetryPolicy_RetriesConnectionReset(t *testing.T) {
+	rt := &FlakyRoundTripper{}
+	client := retryablehttp.NewClient()
+	client.HTTPClient.Transport = rt
+	client.CheckRetry = transport.RetryPolicy
+	client.RetryWaitMin = time.Millisecond
+	client.RetryWaitMax = time.Millisecond
+	client.Logger = nil
+
+	resp, err := client.StandardClient().Get("http://quicknode.invalid/v0/chains")
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	assert.Equal(t, 2, rt.attempts)
+}
+
+func TestRetryPolicy(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		err   error
+		retry bool
+	}{
+		{
+			"connection reset is retried",
+			&net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)},
+			true,
+		},
+		{
+			"temporary dns failure is retried",
+			&net.DNSError{Err: "server misbehaving", Name: "api.quicknode.com", IsTemporary: true},
+			true,
+		},
+		{
+			"dns timeout is retried",
+			&net.DNSError{Err: "i/o timeout", Name: "api.quicknode.com", IsTimeout: true},
+			true,
+		},
+		{
+			"unknown host is not retried",
+			&url.Error{Op: "Get", URL: "https://api.quicknode.invalid", Err: &net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "api.quicknode.invalid", IsNotFound: true}}},
+			false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			retry, err := transport.RetryPolicy(context.Background(), nil, tc.err)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.retry, retry)
+		})
+	}
+}
+
+func TestRetryPolicy_CanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	retry, err := transport.RetryPolicy(ctx, nil, &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET})
+	assert.False(t, retry)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestRetryPolicyWithStatuses(t *testing.T) {
+	policy := transport.RetryPolicyWithStatuses([]int{http.StatusRequestTimeout, http.StatusTooEarly})
+
+	for _, tc := range []struct {
+		name   string
+		status int
+		retry  bool
+	}{
+		{"configured status is retried", http.StatusRequestTimeout, true},
+		{"default retryable status is retried", http.StatusServiceUnavailable, true},
+		{"other status is not retried", http.StatusNotFound, false},
+		{"success is not retried", http.StatusOK, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			retry, err := policy(context.Background(), &http.Response{StatusCode: tc.status}, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.retry, retry)
+		})
+	}
+}
+
+func TestRetriesExhausted_RateLimited(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(server.Close)
+
+	client := transport.NewRetryableThrottledClient(100, nil, nil, nil, 0, nil)
+	_, err := client.Get(server.URL)
+
+	var rateLimited *transport.RateLimitedError
+	if assert.True(t, errors.As(err, &rateLimited), "expected a RateLimitedError, got %v", err) {
+		assert.Equal(t, requests, rateLimited.Attempts)
+		assert.Equal(t, http.MethodGet, rateLimited.Method)
+		assert.Equal(t, server.URL, rateLimited.URL)
+	}
+	assert.Contains(t, utils.BuildClientErrorMessage(err), "Lower requests_per_second")
+}
+
+func TestRetriesExhausted_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	client := transport.NewRetryableThrottledClient(100, nil, nil, nil, 0, nil)
+	_, err := client.Get(strings.Replace(server.URL, "http://", "http://user:secret@", 1))
+
+	var rateLimited *transport.RateLimitedError
+	assert.False(t, errors.As(err, &rateLimited))
+	assert.ErrorContains(t, err, "GET http://user:xxxxx@"+strings.TrimPrefix(server.URL, "http://")+" giving up after")
+	assert.NotContains(t, err.Error(), "secret")
+	assert.NotContains(t, utils.BuildClientErrorMessage(err), "requests_per_second")
+}
@@ -17,7 +17,9 @@
 package transport
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 	"golang.org/x/time/rate"
@@ -31,13 +33,22 @@ type ThrottledTransport struct {
 }
 
 func (c *ThrottledTransport) RoundTrip(r *http.Request) (*http.Response, error) {
-	err := c.ratelimiter.Wait(r.Context())
+	err := throttle(r.Context(), c.ratelimiter)
 	if err != nil {
 		return nil, err
 	}
 	return c.roundTripper.RoundTrip(r)
 }
 
+// throttle waits for the rate limiter, recording the wait in the context's
+// RequestMetrics.
+func throttle(ctx context.Context, rl *rate.Limiter) error {
+	start := time.Now()
+	err := rl.Wait(ctx)
+	recordThrottleWait(ctx, time.Since(start))
+	return err
+}
+
 func NewThrottledTransport(rt http.RoundTripper, rl *rate.Limiter) http.RoundTripper {
 	return &ThrottledTransport{
 		roundTripper: rt,
@@ -45,19 +56,47 @@ func NewThrottledTransport(rt http.RoundTripper, rl *rate.Limiter) http.RoundTri
 	}
 }
 
-func NewRetryableThrottledClient(tokens int) *http.Client {
+// NewRetryableThrottledClient returns a client making at most tokens requests
+// per second. A non-nil concurrency limiter also bounds the requests it has in
+// flight. Responses with any of retryStatuses are retried in addition to those
+// retried by RetryPolicy. A non-nil keys authenticates every attempt with the
+// next key that is not rate limited. A positive maxResponseBytes fails
+// responses with a larger body. A non-nil budget caps the attempts it makes,
+// retries included.
+func NewRetryableThrottledClient(tokens int, concurrency *ConcurrencyLimiter, retryStatuses []int, keys *KeyRotation, maxResponseBytes int64, budget *RequestBudget) *http.Client {
 	limiter := rate.NewLimiter(rate.Limit(tokens), tokens)
 	retryableclient := retryablehttp.NewClient()
+	retryableclient.CheckRetry = RetryPolicyWithStatuses(retryStatuses)
+	retryableclient.ErrorHandler = retriesExhausted
+
+	if maxResponseBytes > 0 {
+		retryableclient.HTTPClient.Transport = NewBodyLimitedTransport(retryableclient.HTTPClient.Transport, maxResponseBytes)
+	}
+
+	if budget != nil {
+		retryableclient.HTTPClient.Transport = NewBudgetedTransport(retryableclient.HTTPClient.Transport, budget)
+	}
+
+	// Throttle every attempt, including retries and the resends of key
+	// rotation, so they all respect the rate limit.
+	retryableclient.HTTPClient.Transport = NewThrottledTransport(retryableclient.HTTPClient.Transport, limiter)
+
+	// Key rotation goes above the budget and the throttle, so each key it
+	// tries is counted and paced as its own request.
+	if keys != nil {
+		retryableclient.HTTPClient.Transport = NewKeyRotatingTransport(retryableclient.HTTPClient.Transport, keys)
+	}
 
-	// Ensure that retries also respect the rate limit.
 	retryableclient.PrepareRetry = func(req *http.Request) error {
-		return limiter.Wait(req.Context())
+		recordRetry(req.Context())
+		return nil
 	}
 
 	client := retryableclient.StandardClient()
 
-	transport := NewThrottledTransport(client.Transport, limiter)
-	client.Transport = transport
+	if concurrency != nil {
+		client.Transport = NewConcurrencyLimitedTransport(client.Transport, concurrency)
+	}
 
 	return client
 }
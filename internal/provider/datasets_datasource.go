@@ -0,0 +1,118 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/circlefin/terraform-provider-quicknode/api/streams"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DatasetsDataSourceModel describes the data structure.
+type DatasetsDataSourceModel struct {
+	Network  types.String `tfsdk:"network"`
+	Datasets types.List   `tfsdk:"datasets"`
+}
+
+var (
+	_ datasource.DataSource              = &DatasetsDataSource{}
+	_ datasource.DataSourceWithConfigure = &DatasetsDataSource{}
+)
+
+// DatasetsDataSource lists the stream datasets the Streams API accepts. The
+// API documents neither an endpoint for this nor which datasets each network
+// supports, so the list is the dataset enum of the API spec for every network.
+type DatasetsDataSource struct {
+	allowUnvalidatedNetwork bool
+}
+
+// Metadata returns the data source type name.
+func (d *DatasetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_datasets"
+}
+
+// Schema defines the schema for the data source.
+func (d *DatasetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the QuickNode Stream datasets the Streams API accepts for a network. " +
+			"The API does not document which datasets each network supports, so every network lists all datasets in the API spec",
+		Attributes: map[string]schema.Attribute{
+			"network": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Network to list datasets for, validated like the `network` of `quicknode_stream`",
+			},
+			"datasets": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Datasets accepted by the Streams API",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured settings to the data source.
+func (d *DatasetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	qnd, ok := req.ProviderData.(QuickNodeData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData type",
+			fmt.Sprintf("Expected QuickNodeData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.allowUnvalidatedNetwork = qnd.AllowUnvalidatedNetwork
+}
+
+// Read reads the data source.
+func (d *DatasetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DatasetsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkNetwork(ctx, data.Network, d.allowUnvalidatedNetwork)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datasets, diags := types.ListValueFrom(ctx, types.StringType, streams.Datasets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Datasets = datasets
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// NewDatasetsDataSource returns a new instance of the data source.
+func NewDatasetsDataSource() datasource.DataSource {
+	return &DatasetsDataSource{}
+}
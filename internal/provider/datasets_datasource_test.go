@@ -0,0 +1,80 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/circlefin/terraform-provider-quicknode/api/streams"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func readDatasetsDataSource(t *testing.T, network string) (DatasetsDataSourceModel, *datasource.ReadResponse) {
+	t.Helper()
+
+	d := NewDatasetsDataSource().(*DatasetsDataSource)
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	d.Read(context.Background(), datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"network":  tftypes.NewValue(tftypes.String, network),
+			"datasets": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+		})},
+	}, resp)
+
+	var data DatasetsDataSourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+	}
+	return data, resp
+}
+
+func TestDatasetsDataSource_Read(t *testing.T) {
+	for _, network := range []string{"ethereum-mainnet", "solana-mainnet"} {
+		t.Run(network, func(t *testing.T) {
+			data, resp := readDatasetsDataSource(t, network)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var datasets []string
+			resp.Diagnostics.Append(data.Datasets.ElementsAs(context.Background(), &datasets, false)...)
+			if len(datasets) != len(streams.Datasets) {
+				t.Errorf("expected the datasets of the API spec %v, got %v", streams.Datasets, datasets)
+			}
+			if !data.Network.Equal(types.StringValue(network)) {
+				t.Errorf("expected network %q, got %v", network, data.Network)
+			}
+		})
+	}
+}
+
+func TestDatasetsDataSource_UnknownNetwork(t *testing.T) {
+	if _, resp := readDatasetsDataSource(t, "newchain-testnet"); !resp.Diagnostics.HasError() {
+		t.Fatalf("expected an error for an unknown network")
+	}
+}
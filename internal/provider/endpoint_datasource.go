@@ -0,0 +1,283 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/circlefin/terraform-provider-quicknode/api/quicknode"
+	"github.com/circlefin/terraform-provider-quicknode/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EndpointDataSource looks up an existing endpoint by id or by its label, so
+// endpoints created outside this configuration can be referenced without
+// hard-coding their urls or tokens.
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &EndpointDataSource{}
+	_ datasource.DataSourceWithConfigure      = &EndpointDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &EndpointDataSource{}
+)
+
+// EndpointDataSourceModel describes the data structure.
+type EndpointDataSourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Label      types.String `tfsdk:"label"`
+	Chain      types.String `tfsdk:"chain"`
+	Network    types.String `tfsdk:"network"`
+	Url        types.String `tfsdk:"url"`
+	WssUrl     types.String `tfsdk:"wss_url"`
+	Multichain types.Bool   `tfsdk:"multichain"`
+	Security   types.Object `tfsdk:"security"`
+}
+
+// EndpointDataSource implements datasource.DataSource.
+type EndpointDataSource struct {
+	client quicknode.ClientWithResponsesInterface
+
+	verboseErrors bool
+}
+
+// Metadata returns the data source type name.
+func (d *EndpointDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint"
+}
+
+// Schema defines the schema for the data source.
+func (d *EndpointDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing QuickNode endpoint by `id` or by its `label`. Exactly one of the two must be set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ID of the endpoint",
+			},
+			"label": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Label of the endpoint. The lookup fails if no endpoint or more than one endpoint has this label.",
+			},
+			"chain": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Chain of the endpoint",
+			},
+			"network": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Network of the endpoint",
+			},
+			"url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "HTTP URL of the endpoint",
+			},
+			"wss_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "WebSocket URL of the endpoint. Null if the endpoint has none.",
+			},
+			"multichain": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether multichain is enabled for the endpoint",
+			},
+			"security": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Security settings of the endpoint",
+				Attributes: map[string]schema.Attribute{
+					"tokens": schema.ListNestedAttribute{
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Computed: true,
+								},
+								"token": schema.StringAttribute{
+									Computed:  true,
+									Sensitive: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EndpointDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	qnd, ok := req.ProviderData.(QuickNodeData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData type",
+			fmt.Sprintf("Expected QuickNodeData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = qnd.Client
+	d.verboseErrors = qnd.VerboseErrors
+}
+
+// ValidateConfig checks exactly one of id and label is set.
+func (d *EndpointDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data EndpointDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Id.IsUnknown() || data.Label.IsUnknown() {
+		return
+	}
+
+	if data.Id.IsNull() == data.Label.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("label"),
+			"Invalid Endpoint Lookup",
+			"Exactly one of id and label must be set",
+		)
+	}
+}
+
+// Read reads the data source.
+func (d *EndpointDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EndpointDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueString()
+	if data.Id.IsNull() {
+		var diags diag.Diagnostics
+		id, diags = d.findEndpointIdByLabel(ctx, data.Label.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	endpointResp, err := d.client.ShowEndpointWithResponse(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("%s - Reading Endpoint", utils.ClientErrorSummary),
+			utils.BuildClientErrorMessage(err),
+		)
+		return
+	}
+
+	if endpointResp.StatusCode() != 200 {
+		m, err := utils.BuildRequestErrorMessage(endpointResp.Status(), endpointResp.Body, d.verboseErrors)
+		if err != nil {
+			resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Reading Endpoint", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("%s - Reading Endpoint", utils.RequestErrorSummary),
+			m,
+		)
+		return
+	}
+
+	endpoint := endpointResp.JSON200.Data
+	data.Id = types.StringValue(endpoint.Id)
+	data.Label = types.StringNull()
+	if endpoint.Label != nil && *endpoint.Label != "" {
+		data.Label = types.StringPointerValue(endpoint.Label)
+	}
+	data.Chain = types.StringValue(endpoint.Chain)
+	data.Network = types.StringValue(endpoint.Network)
+	data.Url = endpointBaseUrl(endpoint.HttpUrl)
+	data.WssUrl = types.StringNull()
+	if endpoint.WssUrl != nil && *endpoint.WssUrl != "" {
+		data.WssUrl = endpointBaseUrl(*endpoint.WssUrl)
+	}
+	data.Multichain = types.BoolValue(endpoint.IsMultichain)
+
+	security, diags := endpointSecurityValue(ctx, endpoint.Security)
+	resp.Diagnostics.Append(diags...)
+	data.Security = security
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findEndpointIdByLabel returns the id of the only endpoint labelled label.
+// The API filter is not guaranteed to be exact, so matches are rechecked.
+func (d *EndpointDataSource) findEndpointIdByLabel(ctx context.Context, label string) (string, diag.Diagnostics) {
+	endpoints, diags := listEndpoints(ctx, d.client, quicknode.ListEndpointsParams{
+		Labels: &[]string{label},
+	}, d.verboseErrors)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	var ids []string
+	for _, endpoint := range endpoints {
+		if endpoint.Label != nil && *endpoint.Label == label {
+			ids = append(ids, endpoint.Id)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		diags.AddAttributeError(
+			path.Root("label"),
+			"Endpoint Not Found",
+			fmt.Sprintf("No endpoint has the label %q", label),
+		)
+		return "", diags
+	case 1:
+		return ids[0], diags
+	default:
+		diags.AddAttributeError(
+			path.Root("label"),
+			"Ambiguous Endpoint Label",
+			fmt.Sprintf("%d endpoints have the label %q (ids: %v), look the endpoint up by id instead", len(ids), label, ids),
+		)
+		return "", diags
+	}
+}
+
+// endpointBaseUrl strips the token path from an endpoint url, matching the
+// url attribute of the endpoint resource.
+func endpointBaseUrl(raw string) types.String {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return types.StringValue(raw)
+	}
+
+	return types.StringValue(fmt.Sprintf("%s://%s", u.Scheme, u.Host))
+}
+
+// NewEndpointDataSource returns a new instance of the data source.
+func NewEndpointDataSource() datasource.DataSource {
+	return &EndpointDataSource{}
+}
@@ -0,0 +1,244 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/circlefin/terraform-provider-quicknode/api/quicknode"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// endpointLookupStubClient serves a fixed list of endpoints. Any call other
+// than listing or showing endpoints panics via the nil embedded interface.
+type endpointLookupStubClient struct {
+	quicknode.ClientWithResponsesInterface
+
+	endpoints  []quicknode.Endpoint
+	lastLabels []string
+	shownId    string
+}
+
+func (s *endpointLookupStubClient) ListEndpointsWithResponse(_ context.Context, params *quicknode.ListEndpointsParams, _ ...quicknode.RequestEditorFn) (*quicknode.ListEndpointsResponse, error) {
+	if params.Labels != nil {
+		s.lastLabels = *params.Labels
+	}
+
+	resp := &quicknode.ListEndpointsResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK, Status: http.StatusText(http.StatusOK)},
+	}
+	resp.JSON200 = &struct {
+		Data       *[]quicknode.Endpoint `json:"data"`
+		Error      *string               `json:"error"`
+		Pagination *struct {
+			Limit  int `json:"limit"`
+			Offset int `json:"offset"`
+			Total  int `json:"total"`
+		} `json:"pagination,omitempty"`
+	}{Data: &s.endpoints}
+
+	return resp, nil
+}
+
+func (s *endpointLookupStubClient) ShowEndpointWithResponse(_ context.Context, id string, _ ...quicknode.RequestEditorFn) (*quicknode.ShowEndpointResponse, error) {
+	s.shownId = id
+
+	for _, endpoint := range s.endpoints {
+		if endpoint.Id != id {
+			continue
+		}
+
+		tokenId, token := "token-id", "secret-token"
+		resp := &quicknode.ShowEndpointResponse{
+			HTTPResponse: &http.Response{StatusCode: http.StatusOK, Status: http.StatusText(http.StatusOK)},
+		}
+		resp.JSON200 = &struct {
+			Data  *quicknode.SingleEndpoint `json:"data,omitempty"`
+			Error *string                   `json:"error"`
+		}{Data: &quicknode.SingleEndpoint{
+			Id:      endpoint.Id,
+			Chain:   endpoint.Chain,
+			Network: endpoint.Network,
+			HttpUrl: endpoint.HttpUrl,
+			WssUrl:  endpoint.WssUrl,
+			Label:   endpoint.Label,
+			Tags:    endpoint.Tags,
+			Security: quicknode.EndpointSecurity{
+				Tokens: &[]quicknode.EndpointToken{{Id: &tokenId, Token: &token}},
+			},
+		}}
+		return resp, nil
+	}
+
+	return &quicknode.ShowEndpointResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusNotFound, Status: http.StatusText(http.StatusNotFound)},
+		Body:         []byte(`{"data":null,"error":"not found"}`),
+	}, nil
+}
+
+func testLookupEndpoint(id, label string) quicknode.Endpoint {
+	wss := "wss://" + id + ".quiknode.pro/secret-token/"
+	return quicknode.Endpoint{
+		Id:      id,
+		Label:   &label,
+		Chain:   "eth",
+		Network: "mainnet",
+		HttpUrl: "https://" + id + ".quiknode.pro/secret-token/",
+		WssUrl:  &wss,
+	}
+}
+
+// readEndpointDataSource reads the data source with the given id and label
+// against client.
+func readEndpointDataSource(t *testing.T, client *endpointLookupStubClient, id, label *string) (EndpointDataSourceModel, *datasource.ReadResponse) {
+	t.Helper()
+
+	d := NewEndpointDataSource().(*EndpointDataSource)
+	d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: QuickNodeData{Client: client}}, &datasource.ConfigureResponse{})
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["id"] = tftypes.NewValue(tftypes.String, id)
+	values["label"] = tftypes.NewValue(tftypes.String, label)
+
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	d.Read(context.Background(), datasource.ReadRequest{Config: config}, resp)
+
+	var result EndpointDataSourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &result)...)
+	}
+	return result, resp
+}
+
+func TestEndpointDataSource_ByLabel(t *testing.T) {
+	client := &endpointLookupStubClient{endpoints: []quicknode.Endpoint{
+		testLookupEndpoint("ep-1", "payments-mainnet"),
+		// The API label filter may also match on a prefix.
+		testLookupEndpoint("ep-2", "payments-mainnet-old"),
+	}}
+	label := "payments-mainnet"
+
+	result, resp := readEndpointDataSource(t, client, nil, &label)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(client.lastLabels) != 1 || client.lastLabels[0] != label {
+		t.Errorf("expected endpoints to be filtered by label %q, got %v", label, client.lastLabels)
+	}
+	if client.shownId != "ep-1" {
+		t.Errorf("expected endpoint ep-1 to be read, got %q", client.shownId)
+	}
+
+	expected := map[string]types.String{
+		"id":      types.StringValue("ep-1"),
+		"label":   types.StringValue(label),
+		"chain":   types.StringValue("eth"),
+		"network": types.StringValue("mainnet"),
+		"url":     types.StringValue("https://ep-1.quiknode.pro"),
+		"wss_url": types.StringValue("wss://ep-1.quiknode.pro"),
+	}
+	got := map[string]types.String{
+		"id":      result.Id,
+		"label":   result.Label,
+		"chain":   result.Chain,
+		"network": result.Network,
+		"url":     result.Url,
+		"wss_url": result.WssUrl,
+	}
+	for name, want := range expected {
+		if !got[name].Equal(want) {
+			t.Errorf("%s: expected %v, got %v", name, want, got[name])
+		}
+	}
+
+	tokens := result.Security.Attributes()["tokens"].(types.List).Elements()
+	if len(tokens) != 1 {
+		t.Fatalf("expected one token, got %d", len(tokens))
+	}
+	if token := tokens[0].(types.Object).Attributes()["token"]; !token.Equal(types.StringValue("secret-token")) {
+		t.Errorf("expected token secret-token, got %v", token)
+	}
+}
+
+func TestEndpointDataSource_ById(t *testing.T) {
+	client := &endpointLookupStubClient{endpoints: []quicknode.Endpoint{testLookupEndpoint("ep-1", "payments-mainnet")}}
+	id := "ep-1"
+
+	result, resp := readEndpointDataSource(t, client, &id, nil)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !result.Label.Equal(types.StringValue("payments-mainnet")) {
+		t.Errorf("expected label payments-mainnet, got %v", result.Label)
+	}
+}
+
+func TestEndpointDataSource_LabelLookupErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		endpoints []quicknode.Endpoint
+		summary   string
+	}{
+		{
+			name:      "missing",
+			endpoints: []quicknode.Endpoint{testLookupEndpoint("ep-1", "other")},
+			summary:   "Endpoint Not Found",
+		},
+		{
+			name: "ambiguous",
+			endpoints: []quicknode.Endpoint{
+				testLookupEndpoint("ep-1", "payments-mainnet"),
+				testLookupEndpoint("ep-2", "payments-mainnet"),
+			},
+			summary: "Ambiguous Endpoint Label",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &endpointLookupStubClient{endpoints: tc.endpoints}
+			label := "payments-mainnet"
+
+			_, resp := readEndpointDataSource(t, client, nil, &label)
+
+			if !resp.Diagnostics.HasError() {
+				t.Fatalf("expected error diagnostics")
+			}
+			if got := resp.Diagnostics.Errors()[0].Summary(); got != tc.summary {
+				t.Errorf("expected summary %q, got %q", tc.summary, got)
+			}
+			if client.shownId != "" {
+				t.Errorf("expected no endpoint to be read, got %q", client.shownId)
+			}
+		})
+	}
+}
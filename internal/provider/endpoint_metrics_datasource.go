@@ -0,0 +1,237 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/circlefin/terraform-provider-quicknode/api/quicknode"
+	"github.com/circlefin/terraform-provider-quicknode/internal/utils"
+	"github.com/circlefin/terraform-provider-quicknode/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EndpointMetricsDataSource summarizes the request, error and response time
+// series the QuickNode API reports for an endpoint over a period.
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &EndpointMetricsDataSource{}
+	_ datasource.DataSourceWithConfigure = &EndpointMetricsDataSource{}
+)
+
+// EndpointMetricsDataSourceModel describes the data structure.
+type EndpointMetricsDataSourceModel struct {
+	Id              types.String  `tfsdk:"id"`
+	Period          types.String  `tfsdk:"period"`
+	RequestCount    types.Int64   `tfsdk:"request_count"`
+	ErrorCount      types.Int64   `tfsdk:"error_count"`
+	ErrorRate       types.Float64 `tfsdk:"error_rate"`
+	MaxResponseTime types.Int64   `tfsdk:"max_response_time"`
+}
+
+// EndpointMetricsDataSource implements datasource.DataSource.
+type EndpointMetricsDataSource struct {
+	client quicknode.ClientWithResponsesInterface
+
+	verboseErrors bool
+}
+
+// Metadata returns the data source type name.
+func (d *EndpointMetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint_metrics"
+}
+
+// Schema defines the schema for the data source.
+func (d *EndpointMetricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Summarizes the metrics of an endpoint over the last `period`. An endpoint without traffic in the period reports zero requests and errors, and a null `error_rate` and `max_response_time`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the endpoint",
+			},
+			"period": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Period to summarize, one of `hour`, `day`, `week` or `month`",
+				Validators: []validator.String{
+					validators.MetricsPeriodValidator,
+				},
+			},
+			"request_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of method calls made to the endpoint",
+			},
+			"error_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of requests to the endpoint that failed",
+			},
+			"error_rate": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "`error_count` divided by `request_count`. Null if there were no requests.",
+			},
+			"max_response_time": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Highest response time in milliseconds reported for the endpoint. Null if none was reported.",
+			},
+		},
+	}
+}
+
+func (d *EndpointMetricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	qnd, ok := req.ProviderData.(QuickNodeData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData type",
+			fmt.Sprintf("Expected QuickNodeData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = qnd.Client
+	d.verboseErrors = qnd.VerboseErrors
+}
+
+// endpointMetricsRead are the metrics the data source summarizes.
+var endpointMetricsRead = []quicknode.FetchEndpointMetricParamsMetric{
+	quicknode.FetchEndpointMetricParamsMetricMethodCallsOverTime,
+	quicknode.FetchEndpointMetricParamsMetricTotalRequestErrorsOverTime,
+	quicknode.FetchEndpointMetricParamsMetricResponseTimeOverTime,
+}
+
+// Read reads the data source.
+func (d *EndpointMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EndpointMetricsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, period := data.Id.ValueString(), data.Period.ValueString()
+
+	series := make(map[quicknode.FetchEndpointMetricParamsMetric][]quicknode.EndpointMetric, len(endpointMetricsRead))
+	for _, metric := range endpointMetricsRead {
+		s, diags := d.fetchEndpointMetric(ctx, id, period, metric)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		series[metric] = s
+	}
+	calls := series[quicknode.FetchEndpointMetricParamsMetricMethodCallsOverTime]
+	errs := series[quicknode.FetchEndpointMetricParamsMetricTotalRequestErrorsOverTime]
+	responseTimes := series[quicknode.FetchEndpointMetricParamsMetricResponseTimeOverTime]
+
+	requestCount, errorCount := sumEndpointMetric(calls), sumEndpointMetric(errs)
+	data.RequestCount = types.Int64Value(requestCount)
+	data.ErrorCount = types.Int64Value(errorCount)
+
+	data.ErrorRate = types.Float64Null()
+	if requestCount > 0 {
+		data.ErrorRate = types.Float64Value(float64(errorCount) / float64(requestCount))
+	}
+
+	data.MaxResponseTime = types.Int64Null()
+	if maxResponseTime, ok := maxEndpointMetric(responseTimes); ok {
+		data.MaxResponseTime = types.Int64Value(maxResponseTime)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fetchEndpointMetric returns the series of metric for the endpoint over period.
+func (d *EndpointMetricsDataSource) fetchEndpointMetric(ctx context.Context, id, period string, metric quicknode.FetchEndpointMetricParamsMetric) ([]quicknode.EndpointMetric, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	metricResp, err := d.client.FetchEndpointMetricWithResponse(ctx, id, &quicknode.FetchEndpointMetricParams{
+		Period: quicknode.FetchEndpointMetricParamsPeriod(period),
+		Metric: metric,
+	})
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("%s - Reading Endpoint Metrics", utils.ClientErrorSummary),
+			utils.BuildClientErrorMessage(err),
+		)
+		return nil, diags
+	}
+
+	if metricResp.StatusCode() != 200 {
+		m, err := utils.BuildRequestErrorMessage(metricResp.Status(), metricResp.Body, d.verboseErrors)
+		if err != nil {
+			diags.AddWarning(fmt.Sprintf("%s - Reading Endpoint Metrics", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
+		}
+
+		diags.AddError(
+			fmt.Sprintf("%s - Reading Endpoint Metrics", utils.RequestErrorSummary),
+			fmt.Sprintf("%s\nmetric `%s`", m, metric),
+		)
+		return nil, diags
+	}
+
+	return metricResp.JSON200.Data, diags
+}
+
+// endpointMetricValues calls f with the value of every point of series. Points
+// are [timestamp, value] pairs, and malformed points are skipped.
+func endpointMetricValues(series []quicknode.EndpointMetric, f func(int64)) {
+	for _, s := range series {
+		if s.Data == nil {
+			continue
+		}
+		for _, point := range *s.Data {
+			if len(point) < 2 {
+				continue
+			}
+			f(int64(point[1]))
+		}
+	}
+}
+
+// sumEndpointMetric returns the total of every point of series.
+func sumEndpointMetric(series []quicknode.EndpointMetric) int64 {
+	var sum int64
+	endpointMetricValues(series, func(v int64) { sum += v })
+	return sum
+}
+
+// maxEndpointMetric returns the largest point of series, and false if series
+// has no points.
+func maxEndpointMetric(series []quicknode.EndpointMetric) (int64, bool) {
+	var largest int64
+	found := false
+	endpointMetricValues(series, func(v int64) {
+		if !found || v > largest {
+			largest = v
+		}
+		found = true
+	})
+	return largest, found
+}
+
+// NewEndpointMetricsDataSource returns a new instance of the data source.
+func NewEndpointMetricsDataSource() datasource.DataSource {
+	return &EndpointMetricsDataSource{}
+}
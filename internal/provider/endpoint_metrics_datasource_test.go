@@ -0,0 +1,160 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/circlefin/terraform-provider-quicknode/api/quicknode"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// metricsStubClient serves fixed series per metric. Any call other than
+// fetching endpoint metrics panics via the nil embedded interface.
+type metricsStubClient struct {
+	quicknode.ClientWithResponsesInterface
+
+	status  int
+	series  map[quicknode.FetchEndpointMetricParamsMetric][]quicknode.EndpointMetric
+	periods []quicknode.FetchEndpointMetricParamsPeriod
+}
+
+func (s *metricsStubClient) FetchEndpointMetricWithResponse(_ context.Context, _ string, params *quicknode.FetchEndpointMetricParams, _ ...quicknode.RequestEditorFn) (*quicknode.FetchEndpointMetricResponse, error) {
+	s.periods = append(s.periods, params.Period)
+
+	resp := &quicknode.FetchEndpointMetricResponse{HTTPResponse: testStubResponse(s.status)}
+	if s.status != http.StatusOK {
+		resp.Body = []byte(`{"data":null,"error":"endpoint not found"}`)
+		return resp, nil
+	}
+
+	resp.JSON200 = &struct {
+		Data  []quicknode.EndpointMetric `json:"data"`
+		Error *string                    `json:"error"`
+	}{Data: s.series[params.Metric]}
+	return resp, nil
+}
+
+func testEndpointMetric(tag string, points ...[]int) quicknode.EndpointMetric {
+	return quicknode.EndpointMetric{Tag: &tag, Data: &points}
+}
+
+func readEndpointMetricsDataSource(t *testing.T, client *metricsStubClient, period string) (EndpointMetricsDataSourceModel, *datasource.ReadResponse) {
+	t.Helper()
+
+	d := NewEndpointMetricsDataSource().(*EndpointMetricsDataSource)
+	d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: QuickNodeData{Client: client}}, &datasource.ConfigureResponse{})
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+	config.Set(context.Background(), &EndpointMetricsDataSourceModel{
+		Id:              types.StringValue("ep-1"),
+		Period:          types.StringValue(period),
+		RequestCount:    types.Int64Null(),
+		ErrorCount:      types.Int64Null(),
+		ErrorRate:       types.Float64Null(),
+		MaxResponseTime: types.Int64Null(),
+	})
+
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	d.Read(context.Background(), datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw},
+	}, resp)
+
+	var result EndpointMetricsDataSourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &result)...)
+	}
+	return result, resp
+}
+
+func TestEndpointMetricsDataSource(t *testing.T) {
+	client := &metricsStubClient{status: http.StatusOK, series: map[quicknode.FetchEndpointMetricParamsMetric][]quicknode.EndpointMetric{
+		quicknode.FetchEndpointMetricParamsMetricMethodCallsOverTime: {
+			testEndpointMetric("eth_call", []int{1700000000, 120}, []int{1700003600, 60}),
+			testEndpointMetric("eth_blockNumber", []int{1700000000, 20}),
+		},
+		quicknode.FetchEndpointMetricParamsMetricTotalRequestErrorsOverTime: {
+			testEndpointMetric("errors", []int{1700000000, 5}, []int{1700003600, 2}),
+		},
+		quicknode.FetchEndpointMetricParamsMetricResponseTimeOverTime: {
+			testEndpointMetric("p50", []int{1700000000, 40}, []int{1700003600, 55}),
+			testEndpointMetric("p99", []int{1700000000, 310}, []int{1700003600}),
+		},
+	}}
+
+	result, resp := readEndpointMetricsDataSource(t, client, "day")
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	for _, period := range client.periods {
+		if period != quicknode.FetchEndpointMetricParamsPeriodDay {
+			t.Errorf("expected metrics to be fetched for period day, got %q", period)
+		}
+	}
+	if !result.RequestCount.Equal(types.Int64Value(200)) {
+		t.Errorf("expected request_count 200, got %v", result.RequestCount)
+	}
+	if !result.ErrorCount.Equal(types.Int64Value(7)) {
+		t.Errorf("expected error_count 7, got %v", result.ErrorCount)
+	}
+	if !result.ErrorRate.Equal(types.Float64Value(0.035)) {
+		t.Errorf("expected error_rate 0.035, got %v", result.ErrorRate)
+	}
+	if !result.MaxResponseTime.Equal(types.Int64Value(310)) {
+		t.Errorf("expected max_response_time 310, got %v", result.MaxResponseTime)
+	}
+}
+
+func TestEndpointMetricsDataSource_NoMetrics(t *testing.T) {
+	result, resp := readEndpointMetricsDataSource(t, &metricsStubClient{status: http.StatusOK}, "hour")
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !result.RequestCount.Equal(types.Int64Value(0)) || !result.ErrorCount.Equal(types.Int64Value(0)) {
+		t.Errorf("expected zero requests and errors, got %v and %v", result.RequestCount, result.ErrorCount)
+	}
+	if !result.ErrorRate.IsNull() {
+		t.Errorf("expected null error_rate, got %v", result.ErrorRate)
+	}
+	if !result.MaxResponseTime.IsNull() {
+		t.Errorf("expected null max_response_time, got %v", result.MaxResponseTime)
+	}
+}
+
+func TestEndpointMetricsDataSource_RequestError(t *testing.T) {
+	_, resp := readEndpointMetricsDataSource(t, &metricsStubClient{status: http.StatusNotFound}, "week")
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error diagnostics for a missing endpoint")
+	}
+	if n := len(resp.Diagnostics.Errors()); n != 1 {
+		t.Errorf("expected reading to stop at the first failed metric, got %d errors", n)
+	}
+}
@@ -20,11 +20,14 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/circlefin/terraform-provider-quicknode/api/quicknode"
+	"github.com/circlefin/terraform-provider-quicknode/internal/client/transport"
 	"github.com/circlefin/terraform-provider-quicknode/internal/utils"
+	"github.com/circlefin/terraform-provider-quicknode/internal/validators"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -35,6 +38,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -57,6 +61,11 @@ var (
 		"id":    types.StringType,
 		"token": types.StringType,
 	}
+	rateLimitsAttributes = map[string]attr.Type{
+		"rps": types.Int64Type,
+		"rpm": types.Int64Type,
+		"rpd": types.Int64Type,
+	}
 )
 
 func NewEndpointResource() resource.Resource {
@@ -65,8 +74,11 @@ func NewEndpointResource() resource.Resource {
 
 // EndpointResource defines the resource implementation.
 type EndpointResource struct {
-	client quicknode.ClientWithResponsesInterface
-	chains []quicknode.Chain
+	client      quicknode.ClientWithResponsesInterface
+	chains      []quicknode.Chain
+	defaultTags []string
+
+	verboseErrors bool
 }
 
 // EndpointResourceModel describes the resource data model.
@@ -78,7 +90,18 @@ type EndpointResourceModel struct {
 	Id         types.String `tfsdk:"id"`
 	Security   types.Object `tfsdk:"security"`
 	Tags       types.Set    `tfsdk:"tags"`
+	TagsAll    types.Set    `tfsdk:"tags_all"`
 	Multichain types.Bool   `tfsdk:"multichain"`
+	Protected  types.Bool   `tfsdk:"protected"`
+	RateLimits types.Object `tfsdk:"rate_limits"`
+
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+}
+
+type EndpointResourceRateLimits struct {
+	Rps types.Int64 `tfsdk:"rps"`
+	Rpm types.Int64 `tfsdk:"rpm"`
+	Rpd types.Int64 `tfsdk:"rpd"`
 }
 
 type EndpointResourceSecurityToken struct {
@@ -112,6 +135,9 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 			"label": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "Label to decorate an endpoint with",
+				Validators: []validator.String{
+					validators.EndpointLabelValidator,
+				},
 			},
 			"url": schema.StringAttribute{
 				Computed:            true,
@@ -161,12 +187,48 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 				Optional:            true,
 				MarkdownDescription: "Tags to associate with the endpoint",
 			},
+			"tags_all": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Tags on the endpoint, including the provider's `default_tags`",
+			},
 			"multichain": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 				MarkdownDescription: "Whether multichain is enabled for the endpoint.",
 			},
+			"protected": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the provider refuses to delete the endpoint. Set to `false` and apply before destroying or replacing a protected endpoint.",
+			},
+			"rate_limits": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"rps": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Maximum requests per second",
+						Validators:          []validator.Int64{validators.EndpointRateLimitValidator},
+					},
+					"rpm": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Maximum requests per minute",
+						Validators:          []validator.Int64{validators.EndpointRateLimitValidator},
+					},
+					"rpd": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Maximum requests per day",
+						Validators:          []validator.Int64{validators.EndpointRateLimitValidator},
+					},
+				},
+				Optional:            true,
+				MarkdownDescription: "Rate limits overriding those of the account's plan for the endpoint. QuickNode caps each limit by the plan. Removing a limit from the configuration leaves it in place on the endpoint.",
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Adopt the endpoint already on `chain` and `network` instead of creating one, failing if there are several. Its label, rate limits, tags and multichain setting are then updated to match the configuration, and destroying the resource deletes it. Only used on create. Defaults to false.",
+			},
 		},
 	}
 }
@@ -180,6 +242,26 @@ func (r *EndpointResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 			return
 		}
 
+		if !data.Tags.IsUnknown() {
+			tagsAll, diags := mergeTags(ctx, data.Tags, r.defaultTags)
+			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		// Chains are nil when the provider could not fetch them, in which
+		// case QuickNode validates chain and network on apply instead.
+		if r.chains == nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("network"),
+				"Chain and Network Not Validated",
+				"The provider could not fetch chains from QuickNode, so chain and network are not validated until apply.",
+			)
+			return
+		}
+
 		var validChainSlugs []string
 		var validNetworkSlugs []string
 		for _, chain := range r.chains {
@@ -233,9 +315,120 @@ func (r *EndpointResource) Configure(ctx context.Context, req resource.Configure
 
 	r.client = qnd.Client
 	r.chains = qnd.Chains
+	r.defaultTags = qnd.DefaultTags
+	r.verboseErrors = qnd.VerboseErrors
+}
+
+// checkEndpointNetworkDrift reports an endpoint whose chain or network was
+// changed outside Terraform, ignoring case as ModifyPlan does. Both require
+// replacement, so adopting the server value would leave the endpoint on a
+// network the configuration never asked for.
+func checkEndpointNetworkDrift(prior EndpointResourceModel, chain, network string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, attr := range []struct {
+		name   string
+		stored types.String
+		server string
+	}{
+		{"chain", prior.Chain, chain},
+		{"network", prior.Network, network},
+	} {
+		if attr.stored.IsNull() || attr.stored.IsUnknown() || strings.EqualFold(attr.stored.ValueString(), attr.server) {
+			continue
+		}
+
+		diags.AddAttributeError(
+			path.Root(attr.name),
+			"Endpoint Network Drift",
+			fmt.Sprintf("Endpoint %s is on %s %q but state has %q. The %s was changed outside Terraform; "+
+				"remove the endpoint from state and import it again to adopt it, or replace the endpoint.",
+				prior.Id.ValueString(), attr.name, attr.server, attr.stored.ValueString(), attr.name),
+		)
+	}
+
+	return diags
+}
+
+// endpointSecurityValue converts an endpoint's security tokens to the
+// security attribute, or null when the endpoint has none.
+func endpointSecurityValue(ctx context.Context, security quicknode.EndpointSecurity) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if security.Tokens == nil {
+		return types.ObjectNull(securityAttributes), diags
+	}
+
+	var tokens []basetypes.ObjectValuable
+	for _, token := range *security.Tokens {
+		tokenValue, d := types.ObjectValue(tokensAttributes, map[string]attr.Value{
+			"id":    types.StringValue(*token.Id),
+			"token": types.StringValue(*token.Token),
+		})
+
+		diags.Append(d...)
+		tokens = append(tokens, tokenValue)
+	}
+
+	tokensValueList, d := types.ListValueFrom(ctx, basetypes.ObjectType{AttrTypes: tokensAttributes}, tokens)
+	diags.Append(d...)
+
+	securityValueObject, d := types.ObjectValue(securityAttributes, map[string]attr.Value{
+		"tokens": tokensValueList,
+	})
+	diags.Append(d...)
+
+	return securityValueObject, diags
+}
+
+// mergeTags returns tags together with defaults, or null when both are empty.
+func mergeTags(ctx context.Context, tags types.Set, defaults []string) (types.Set, diag.Diagnostics) {
+	var merged []string
+	diags := tags.ElementsAs(ctx, &merged, false)
+
+	for _, tag := range defaults {
+		if !slices.Contains(merged, tag) {
+			merged = append(merged, tag)
+		}
+	}
+
+	if len(merged) == 0 {
+		return types.SetNull(types.StringType), diags
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, merged)
+	diags.Append(d...)
+	return set, diags
+}
+
+// splitTags separates the tags read from an endpoint into those to record in
+// tags, dropping default tags unless prior, the tags previously in state, also
+// set them.
+func splitTags(ctx context.Context, tagsAll []string, defaults []string, prior types.Set) (types.Set, diag.Diagnostics) {
+	var priorTags []string
+	diags := prior.ElementsAs(ctx, &priorTags, false)
+
+	var tags []string
+	for _, tag := range tagsAll {
+		if slices.Contains(defaults, tag) && !slices.Contains(priorTags, tag) {
+			continue
+		}
+		tags = append(tags, tag)
+	}
+
+	if len(tags) == 0 {
+		return types.SetNull(types.StringType), diags
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, tags)
+	diags.Append(d...)
+	return set, diags
 }
 
 func (r *EndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, metrics := transport.WithRequestMetrics(ctx)
+	defer logRequestMetrics(ctx, "create", metrics)
+
 	var data EndpointResourceModel
 
 	// Read Terraform plan data into the model
@@ -245,64 +438,60 @@ func (r *EndpointResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	endpointResp, err := r.client.CreateEndpointWithResponse(
-		ctx,
-		quicknode.CreateEndpointJSONRequestBody{
-			Chain:   data.Chain.ValueStringPointer(),
-			Network: data.Network.ValueStringPointer(),
-		},
-	)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("%s - Creating Endpoint", utils.ClientErrorSummary),
-			utils.BuildClientErrorMessage(err),
-		)
-		return
+	var endpoint *quicknode.SingleEndpoint
+	if data.AdoptExisting.ValueBool() {
+		endpoint = r.adoptEndpoint(ctx, data.Chain.ValueString(), data.Network.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	if endpointResp.StatusCode() != 200 {
-		m, err := utils.BuildRequestErrorMessage(endpointResp.Status(), endpointResp.Body)
+	if endpoint == nil {
+		endpointResp, err := r.client.CreateEndpointWithResponse(
+			ctx,
+			quicknode.CreateEndpointJSONRequestBody{
+				Chain:   data.Chain.ValueStringPointer(),
+				Network: data.Network.ValueStringPointer(),
+			},
+		)
 		if err != nil {
-			resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Creating Endpoint", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("%s - Creating Endpoint", utils.ClientErrorSummary),
+				utils.BuildClientErrorMessage(err),
+			)
+			return
 		}
 
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("%s - Creating Endpoint", utils.RequestErrorSummary),
-			m,
-		)
-		return
+		if endpointResp.StatusCode() != 200 {
+			m, err := utils.BuildRequestErrorMessage(endpointResp.Status(), endpointResp.Body, r.verboseErrors)
+			if err != nil {
+				resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Creating Endpoint", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
+			}
+
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("%s - Creating Endpoint", utils.RequestErrorSummary),
+				m,
+			)
+			return
+		}
+
+		endpoint = &endpointResp.JSON200.Data
 	}
 
-	endpoint := endpointResp.JSON200.Data
 	data.Id = types.StringValue(endpoint.Id)
 	u, _ := url.Parse(endpoint.HttpUrl)
 	data.Url = types.StringValue(fmt.Sprintf("%s://%s", u.Scheme, u.Host))
-	data.Security = types.ObjectNull(securityAttributes)
-	if endpoint.Security.Tokens != nil {
-		var tokens []basetypes.ObjectValuable
-		for _, token := range *endpoint.Security.Tokens {
-			tokenValue, diags := types.ObjectValue(tokensAttributes, map[string]attr.Value{
-				"id":    types.StringValue(*token.Id),
-				"token": types.StringValue(*token.Token),
-			})
-
-			resp.Diagnostics.Append(diags...)
-			tokens = append(tokens, tokenValue)
-		}
-
-		tokensValueList, diags := types.ListValueFrom(ctx, basetypes.ObjectType{AttrTypes: tokensAttributes}, tokens)
-
-		resp.Diagnostics.Append(diags...)
-		securityValueObject, diags := types.ObjectValue(securityAttributes, map[string]attr.Value{
-			"tokens": tokensValueList,
-		})
-
-		resp.Diagnostics.Append(diags...)
-		data.Security = securityValueObject
+	security, diags := endpointSecurityValue(ctx, endpoint.Security)
+	resp.Diagnostics.Append(diags...)
+	data.Security = security
+
+	// An adopted endpoint may already have a label, which is cleared when
+	// none is configured, as Update does.
+	current := ""
+	if endpoint.Label != nil {
+		current = *endpoint.Label
 	}
-
-	l := data.Label.ValueString()
-	if l != "" {
+	if l := data.Label.ValueString(); l != current {
 		endpointUpdateResp, err := r.client.UpdateEndpointWithResponse(
 			ctx,
 			data.Id.ValueString(),
@@ -315,10 +504,8 @@ func (r *EndpointResource) Create(ctx context.Context, req resource.CreateReques
 				fmt.Sprintf("%s - Patching Endpoint Label", utils.ClientErrorSummary),
 				utils.BuildClientErrorMessage(err),
 			)
-		}
-
-		if endpointUpdateResp.StatusCode() != 200 {
-			m, err := utils.BuildRequestErrorMessage(endpointResp.Status(), endpointResp.Body)
+		} else if endpointUpdateResp.StatusCode() != 200 {
+			m, err := utils.BuildRequestErrorMessage(endpointUpdateResp.Status(), endpointUpdateResp.Body, r.verboseErrors)
 			if err != nil {
 				resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Patching Endpoint Label", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
 			}
@@ -328,11 +515,45 @@ func (r *EndpointResource) Create(ctx context.Context, req resource.CreateReques
 				m,
 			)
 		}
+
+		if resp.Diagnostics.HasError() {
+			// Save the endpoint without its label rather than orphaning it in
+			// QuickNode. Terraform taints it, so the next apply replaces it.
+			data.Label = types.StringPointerValue(endpoint.Label)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	if !data.RateLimits.IsNull() {
+		r.setRateLimits(ctx, data.Id.ValueString(), data.RateLimits, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			// As with the label, save the endpoint rather than orphaning it.
+			data.RateLimits = types.ObjectNull(rateLimitsAttributes)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	// An adopted endpoint keeps the tags it already has that are configured,
+	// and loses the others.
+	existingTags := make(map[string]int)
+	if endpoint.Tags != nil {
+		for _, tag := range *endpoint.Tags {
+			if tag.Label != nil && tag.TagId != nil {
+				existingTags[*tag.Label] = *tag.TagId
+			}
+		}
 	}
 
 	var tags []string
-	data.Tags.ElementsAs(ctx, &tags, false)
+	data.TagsAll.ElementsAs(ctx, &tags, false)
 	for _, tag := range tags {
+		if _, exists := existingTags[tag]; exists {
+			delete(existingTags, tag)
+			continue
+		}
+
 		tagResp, err := r.client.CreateTagWithResponse(
 			ctx,
 			data.Id.ValueString(),
@@ -347,7 +568,7 @@ func (r *EndpointResource) Create(ctx context.Context, req resource.CreateReques
 			)
 			return
 		} else if tagResp.StatusCode() != 200 {
-			m, err := utils.BuildRequestErrorMessage(tagResp.Status(), tagResp.Body)
+			m, err := utils.BuildRequestErrorMessage(tagResp.Status(), tagResp.Body, r.verboseErrors)
 			if err != nil {
 				resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Creating Tag", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
 			}
@@ -360,12 +581,38 @@ func (r *EndpointResource) Create(ctx context.Context, req resource.CreateReques
 		}
 	}
 
-	if data.Multichain.ValueBool() {
-		// Save state before the remote toggle so an enable failure leaves the
-		// caller with a valid resource id to recover from on the next apply
-		// rather than orphaning the endpoint in QuickNode.
+	for label, id := range existingTags {
+		delResp, err := r.client.DeleteTagWithResponse(
+			ctx,
+			data.Id.ValueString(),
+			strconv.Itoa(id),
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("%s - Deleting Tag: %s", utils.ClientErrorSummary, label),
+				utils.BuildClientErrorMessage(err),
+			)
+			return
+		} else if delResp.StatusCode() != 200 {
+			m, err := utils.BuildRequestErrorMessage(delResp.Status(), delResp.Body, r.verboseErrors)
+			if err != nil {
+				resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Deleting Tag", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
+			}
+
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("%s - Deleting Tag: %s", utils.RequestErrorSummary, label),
+				m,
+			)
+			return
+		}
+	}
+
+	if data.Multichain.ValueBool() != endpoint.IsMultichain {
+		// Save state before the remote toggle so a failure leaves the caller
+		// with a valid resource id to recover from on the next apply rather
+		// than orphaning the endpoint in QuickNode.
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-		r.setMultichain(ctx, data.Id.ValueString(), true, &resp.Diagnostics)
+		r.setMultichain(ctx, data.Id.ValueString(), data.Multichain.ValueBool(), &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -411,7 +658,7 @@ func (r *EndpointResource) setMultichain(ctx context.Context, id string, enable
 		return
 	}
 	if status != 200 {
-		m, err := utils.BuildRequestErrorMessage(statusText, body)
+		m, err := utils.BuildRequestErrorMessage(statusText, body, r.verboseErrors)
 		if err != nil {
 			diags.AddWarning(fmt.Sprintf("%s - %s Multichain", utils.InternalErrorSummary, action), utils.BuildInternalErrorMessage(err))
 		}
@@ -422,6 +669,91 @@ func (r *EndpointResource) setMultichain(ctx context.Context, id string, enable
 	}
 }
 
+// setRateLimits overrides the rate limits of an endpoint with those set in
+// rateLimits. Limits left null are not sent, so QuickNode keeps their current
+// values.
+func (r *EndpointResource) setRateLimits(ctx context.Context, id string, rateLimits types.Object, diags *diag.Diagnostics) {
+	var limits EndpointResourceRateLimits
+	diags.Append(rateLimits.As(ctx, &limits, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return
+	}
+
+	var body quicknode.UpdateRateLimitsJSONRequestBody
+	body.RateLimits.Rps = rateLimitPointer(limits.Rps)
+	body.RateLimits.Rpm = rateLimitPointer(limits.Rpm)
+	body.RateLimits.Rpd = rateLimitPointer(limits.Rpd)
+
+	// QuickNode rejects an update without any limit.
+	if body.RateLimits.Rps == nil && body.RateLimits.Rpm == nil && body.RateLimits.Rpd == nil {
+		return
+	}
+
+	rateLimitsResp, err := r.client.UpdateRateLimitsWithResponse(ctx, id, body)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("%s - Updating Endpoint Rate Limits", utils.ClientErrorSummary),
+			utils.BuildClientErrorMessage(err),
+		)
+		return
+	}
+
+	if rateLimitsResp.StatusCode() != 200 {
+		m, err := utils.BuildRequestErrorMessage(rateLimitsResp.Status(), rateLimitsResp.Body, r.verboseErrors)
+		if err != nil {
+			diags.AddWarning(fmt.Sprintf("%s - Updating Endpoint Rate Limits", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
+		}
+
+		diags.AddError(
+			fmt.Sprintf("%s - Updating Endpoint Rate Limits", utils.RequestErrorSummary),
+			m,
+		)
+	}
+}
+
+func rateLimitPointer(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	limit := int(v.ValueInt64())
+	return &limit
+}
+
+// readRateLimits refreshes the limits set in prior from those QuickNode reports
+// for the endpoint. Limits absent from prior are left out of state, since
+// QuickNode also reports the plan's limits for them.
+func readRateLimits(ctx context.Context, prior types.Object, read *quicknode.EndpointRateLimits) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if prior.IsNull() || prior.IsUnknown() || read == nil {
+		return prior, diags
+	}
+
+	var limits EndpointResourceRateLimits
+	diags.Append(prior.As(ctx, &limits, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return prior, diags
+	}
+
+	refresh := func(stored types.Int64, read *int) types.Int64 {
+		if stored.IsNull() {
+			return stored
+		}
+		if read == nil {
+			return types.Int64Null()
+		}
+		return types.Int64Value(int64(*read))
+	}
+	limits.Rps = refresh(limits.Rps, read.Rps)
+	limits.Rpm = refresh(limits.Rpm, read.Rpm)
+	limits.Rpd = refresh(limits.Rpd, read.Rpd)
+
+	value, d := types.ObjectValueFrom(ctx, rateLimitsAttributes, limits)
+	diags.Append(d...)
+	return value, diags
+}
+
 func (r *EndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data EndpointResourceModel
 
@@ -445,7 +777,7 @@ func (r *EndpointResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	if endpointResp.StatusCode() != 200 {
-		m, err := utils.BuildRequestErrorMessage(endpointResp.Status(), endpointResp.Body)
+		m, err := utils.BuildRequestErrorMessage(endpointResp.Status(), endpointResp.Body, r.verboseErrors)
 		if err != nil {
 			resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Reading Endpoint", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
 		}
@@ -458,51 +790,54 @@ func (r *EndpointResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	endpoint := endpointResp.JSON200.Data
-	data.Chain = types.StringValue(endpoint.Chain)
-	data.Network = types.StringValue(endpoint.Network)
+
+	resp.Diagnostics.Append(checkEndpointNetworkDrift(data, endpoint.Chain, endpoint.Network)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Chain = storedNetwork(types.StringValue(endpoint.Chain), data.Chain)
+	data.Network = storedNetwork(types.StringValue(endpoint.Network), data.Network)
 	data.Label = types.StringNull()
 	if endpoint.Label != nil && *endpoint.Label != "" {
 		data.Label = types.StringPointerValue(endpoint.Label)
 	}
 	u, _ := url.Parse(endpoint.HttpUrl)
 	data.Url = types.StringValue(fmt.Sprintf("%s://%s", u.Scheme, u.Host))
-	data.Security = types.ObjectNull(securityAttributes)
-	if endpoint.Security.Tokens != nil {
-		var tokens []basetypes.ObjectValuable
-		for _, token := range *endpoint.Security.Tokens {
-			tokenValue, diags := types.ObjectValue(tokensAttributes, map[string]attr.Value{
-				"id":    types.StringValue(*token.Id),
-				"token": types.StringValue(*token.Token),
-			})
+	security, diags := endpointSecurityValue(ctx, endpoint.Security)
+	resp.Diagnostics.Append(diags...)
+	data.Security = security
 
-			resp.Diagnostics.Append(diags...)
-			tokens = append(tokens, tokenValue)
-		}
-
-		tokensValueList, diags := types.ListValueFrom(ctx, basetypes.ObjectType{AttrTypes: tokensAttributes}, tokens)
-
-		resp.Diagnostics.Append(diags...)
-		securityValueObject, diags := types.ObjectValue(securityAttributes, map[string]attr.Value{
-			"tokens": tokensValueList,
-		})
+	data.Multichain = types.BoolValue(endpoint.IsMultichain)
 
-		resp.Diagnostics.Append(diags...)
-		data.Security = securityValueObject
+	// protected is enforced by the provider only and is never sent to
+	// QuickNode, so imported endpoints start out unprotected.
+	if data.Protected.IsNull() {
+		data.Protected = types.BoolValue(false)
 	}
 
-	data.Multichain = types.BoolValue(endpoint.IsMultichain)
+	rateLimits, diags := readRateLimits(ctx, data.RateLimits, endpoint.RateLimits)
+	resp.Diagnostics.Append(diags...)
+	data.RateLimits = rateLimits
 
-	data.Tags = types.SetNull(types.StringType)
-	if endpoint.Tags != nil && len(*endpoint.Tags) > 0 {
-		var tags []string
+	var tagsAll []string
+	if endpoint.Tags != nil {
 		for _, tag := range *endpoint.Tags {
 			if tag.Label != nil {
-				tags = append(tags, *tag.Label)
+				tagsAll = append(tagsAll, *tag.Label)
 			}
 		}
-		t, diags := types.SetValueFrom(ctx, types.StringType, tags)
+	}
+
+	tags, diags := splitTags(ctx, tagsAll, r.defaultTags, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	data.Tags = tags
+
+	data.TagsAll = types.SetNull(types.StringType)
+	if len(tagsAll) > 0 {
+		t, diags := types.SetValueFrom(ctx, types.StringType, tagsAll)
 		resp.Diagnostics.Append(diags...)
-		data.Tags = t
+		data.TagsAll = t
 	}
 
 	// Save updated data into Terraform state
@@ -510,6 +845,9 @@ func (r *EndpointResource) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (r *EndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, metrics := transport.WithRequestMetrics(ctx)
+	defer logRequestMetrics(ctx, "update", metrics)
+
 	var data, state EndpointResourceModel
 
 	// Read Terraform plan data into the model
@@ -538,7 +876,7 @@ func (r *EndpointResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	if endpointResp.StatusCode() != 200 {
-		m, err := utils.BuildRequestErrorMessage(endpointResp.Status(), endpointResp.Body)
+		m, err := utils.BuildRequestErrorMessage(endpointResp.Status(), endpointResp.Body, r.verboseErrors)
 		if err != nil {
 			resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Patching Endpoint", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
 		}
@@ -560,7 +898,7 @@ func (r *EndpointResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 	if currentEndpointResp.StatusCode() != 200 {
-		m, err := utils.BuildRequestErrorMessage(currentEndpointResp.Status(), currentEndpointResp.Body)
+		m, err := utils.BuildRequestErrorMessage(currentEndpointResp.Status(), currentEndpointResp.Body, r.verboseErrors)
 		if err != nil {
 			resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Reading Endpoint for Tags", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
 		}
@@ -581,7 +919,7 @@ func (r *EndpointResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	var planTags []string
-	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &planTags, false)...)
+	resp.Diagnostics.Append(data.TagsAll.ElementsAs(ctx, &planTags, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -610,7 +948,7 @@ func (r *EndpointResource) Update(ctx context.Context, req resource.UpdateReques
 				return
 			}
 			if tagResp.StatusCode() != 200 {
-				m, err := utils.BuildRequestErrorMessage(tagResp.Status(), tagResp.Body)
+				m, err := utils.BuildRequestErrorMessage(tagResp.Status(), tagResp.Body, r.verboseErrors)
 				if err != nil {
 					resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Creating Tag", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
 				}
@@ -639,7 +977,7 @@ func (r *EndpointResource) Update(ctx context.Context, req resource.UpdateReques
 				return
 			}
 			if delResp.StatusCode() != 200 {
-				m, err := utils.BuildRequestErrorMessage(delResp.Status(), delResp.Body)
+				m, err := utils.BuildRequestErrorMessage(delResp.Status(), delResp.Body, r.verboseErrors)
 				if err != nil {
 					resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Deleting Tag", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
 				}
@@ -663,6 +1001,13 @@ func (r *EndpointResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 	}
 
+	if !data.RateLimits.IsNull() && !data.RateLimits.Equal(state.RateLimits) {
+		r.setRateLimits(ctx, data.Id.ValueString(), data.RateLimits, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -676,6 +1021,15 @@ func (r *EndpointResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	if data.Protected.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("protected"),
+			"Protected Endpoint",
+			fmt.Sprintf("Endpoint %s is protected and will not be deleted. Set protected = false and apply before destroying or replacing it.", data.Id.ValueString()),
+		)
+		return
+	}
+
 	endpointResp, err := r.client.ArchiveEndpointWithResponse(
 		ctx,
 		data.Id.ValueString(),
@@ -689,7 +1043,7 @@ func (r *EndpointResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	if endpointResp.StatusCode() != 200 {
-		m, err := utils.BuildRequestErrorMessage(endpointResp.Status(), endpointResp.Body)
+		m, err := utils.BuildRequestErrorMessage(endpointResp.Status(), endpointResp.Body, r.verboseErrors)
 		if err != nil {
 			resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Deleting Endpoint", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
 		}
@@ -702,6 +1056,160 @@ func (r *EndpointResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 }
 
+// ImportState imports an endpoint by id, or by chain and network given as
+// chain/network when only one endpoint is on that network.
 func (r *EndpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	chain, network, ok := strings.Cut(req.ID, "/")
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	if chain == "" || network == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an endpoint id or chain/network, such as eth/mainnet, got: %q", req.ID),
+		)
+		return
+	}
+
+	ids, diags := endpointIdsOnNetwork(ctx, r.client, chain, network, r.verboseErrors)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch len(ids) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Endpoint Not Found",
+			fmt.Sprintf("No endpoint is on chain %q and network %q", chain, network),
+		)
+	case 1:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ids[0])...)
+	default:
+		resp.Diagnostics.AddError(
+			"Ambiguous Endpoint Network",
+			fmt.Sprintf("%d endpoints are on chain %q and network %q (ids: %v), import the endpoint by id instead", len(ids), chain, network, ids),
+		)
+	}
+}
+
+// adoptEndpoint returns the endpoint already on chain and network for
+// adopt_existing, or nil if there is none.
+func (r *EndpointResource) adoptEndpoint(ctx context.Context, chain, network string, diags *diag.Diagnostics) *quicknode.SingleEndpoint {
+	ids, d := endpointIdsOnNetwork(ctx, r.client, chain, network, r.verboseErrors)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil
+	}
+
+	switch len(ids) {
+	case 0:
+		return nil
+	case 1:
+	default:
+		diags.AddAttributeError(
+			path.Root("adopt_existing"),
+			"Ambiguous Endpoint Network",
+			fmt.Sprintf("%d endpoints are on chain %q and network %q (ids: %v), import the endpoint to adopt by id instead", len(ids), chain, network, ids),
+		)
+		return nil
+	}
+
+	endpointResp, err := r.client.ShowEndpointWithResponse(ctx, ids[0])
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("%s - Reading Endpoint", utils.ClientErrorSummary),
+			utils.BuildClientErrorMessage(err),
+		)
+		return nil
+	}
+
+	if endpointResp.StatusCode() != 200 || endpointResp.JSON200.Data == nil {
+		m, err := utils.BuildRequestErrorMessage(endpointResp.Status(), endpointResp.Body, r.verboseErrors)
+		if err != nil {
+			diags.AddWarning(fmt.Sprintf("%s - Reading Endpoint", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
+		}
+
+		diags.AddError(
+			fmt.Sprintf("%s - Reading Endpoint", utils.RequestErrorSummary),
+			m,
+		)
+		return nil
+	}
+
+	tflog.Info(ctx, "Adopting existing endpoint", map[string]interface{}{
+		"endpoint_id": ids[0],
+	})
+
+	return endpointResp.JSON200.Data
+}
+
+// endpointIdsOnNetwork returns the ids of the endpoints on chain and network,
+// ignoring case.
+func endpointIdsOnNetwork(ctx context.Context, client quicknode.ClientWithResponsesInterface, chain, network string, verboseErrors bool) ([]string, diag.Diagnostics) {
+	endpoints, diags := listEndpoints(ctx, client, quicknode.ListEndpointsParams{}, verboseErrors)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var ids []string
+	for _, endpoint := range endpoints {
+		if strings.EqualFold(endpoint.Chain, chain) && strings.EqualFold(endpoint.Network, network) {
+			ids = append(ids, endpoint.Id)
+		}
+	}
+
+	return ids, diags
+}
+
+// endpointListPageSize is the number of endpoints listEndpoints requests per page.
+const endpointListPageSize = 100
+
+// listEndpoints returns every endpoint matching params, following pagination.
+func listEndpoints(ctx context.Context, client quicknode.ClientWithResponsesInterface, params quicknode.ListEndpointsParams, verboseErrors bool) ([]quicknode.Endpoint, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var endpoints []quicknode.Endpoint
+
+	limit, offset := endpointListPageSize, 0
+	params.Limit = &limit
+
+	for {
+		page := offset
+		params.Offset = &page
+
+		listResp, err := client.ListEndpointsWithResponse(ctx, &params)
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("%s - Listing Endpoints", utils.ClientErrorSummary),
+				utils.BuildClientErrorMessage(err),
+			)
+			return nil, diags
+		}
+
+		if listResp.StatusCode() != 200 {
+			m, err := utils.BuildRequestErrorMessage(listResp.Status(), listResp.Body, verboseErrors)
+			if err != nil {
+				diags.AddWarning(fmt.Sprintf("%s - Listing Endpoints", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
+			}
+
+			diags.AddError(
+				fmt.Sprintf("%s - Listing Endpoints", utils.RequestErrorSummary),
+				m,
+			)
+			return nil, diags
+		}
+
+		if listResp.JSON200.Data == nil || len(*listResp.JSON200.Data) == 0 {
+			return endpoints, diags
+		}
+		endpoints = append(endpoints, *listResp.JSON200.Data...)
+
+		pagination := listResp.JSON200.Pagination
+		if pagination == nil || len(endpoints) >= pagination.Total {
+			return endpoints, diags
+		}
+		offset += len(*listResp.JSON200.Data)
+	}
 }
@@ -18,15 +18,21 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"testing"
 
 	"github.com/circlefin/terraform-provider-quicknode/api/quicknode"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -297,3 +303,687 @@ func TestMultichainDiff_NullVsFalse(t *testing.T) {
 		t.Fatalf("sanity: Equal() should still distinguish null and false; this test only guards against using Equal() for the diff")
 	}
 }
+
+func testEndpointState(t *testing.T, data EndpointResourceModel) tfsdk.State {
+	t.Helper()
+
+	var resp fwresource.SchemaResponse
+	(&EndpointResource{}).Schema(context.Background(), fwresource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("building schema: %v", resp.Diagnostics)
+	}
+
+	state := tfsdk.State{Schema: resp.Schema, Raw: tftypes.NewValue(resp.Schema.Type().TerraformType(context.Background()), nil)}
+	if diags := state.Set(context.Background(), &data); diags.HasError() {
+		t.Fatalf("building state: %v", diags)
+	}
+	return state
+}
+
+func testEndpointModel(protected bool) EndpointResourceModel {
+	return EndpointResourceModel{
+		Id:         types.StringValue("endpoint-123"),
+		Chain:      types.StringValue("eth"),
+		Network:    types.StringValue("mainnet"),
+		Label:      types.StringNull(),
+		Url:        types.StringValue("https://example.quiknode.pro"),
+		Security:   types.ObjectNull(securityAttributes),
+		Tags:       types.SetNull(types.StringType),
+		TagsAll:    types.SetNull(types.StringType),
+		Multichain: types.BoolValue(false),
+		Protected:  types.BoolValue(protected),
+		RateLimits: types.ObjectNull(rateLimitsAttributes),
+	}
+}
+
+// archiveStubClient records ArchiveEndpoint calls; any other call panics via
+// the nil embedded interface.
+type archiveStubClient struct {
+	quicknode.ClientWithResponsesInterface
+
+	archiveCalls int
+}
+
+func (s *archiveStubClient) ArchiveEndpointWithResponse(_ context.Context, _ string, _ ...quicknode.RequestEditorFn) (*quicknode.ArchiveEndpointResponse, error) {
+	s.archiveCalls++
+	return &quicknode.ArchiveEndpointResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK, Status: http.StatusText(http.StatusOK)},
+		Body:         []byte(`{"data":null,"error":null}`),
+	}, nil
+}
+
+func TestEndpointDelete_Protected(t *testing.T) {
+	stub := &archiveStubClient{}
+	r := &EndpointResource{client: stub}
+	resp := &fwresource.DeleteResponse{}
+
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: testEndpointState(t, testEndpointModel(true))}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error diagnostics deleting a protected endpoint")
+	}
+	if got := resp.Diagnostics.Errors()[0].Summary(); got != "Protected Endpoint" {
+		t.Errorf("expected summary 'Protected Endpoint', got %q", got)
+	}
+	if stub.archiveCalls != 0 {
+		t.Errorf("expected no archive calls, got %d", stub.archiveCalls)
+	}
+}
+
+func TestEndpointDelete_Unprotected(t *testing.T) {
+	stub := &archiveStubClient{}
+	r := &EndpointResource{client: stub}
+	resp := &fwresource.DeleteResponse{}
+
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: testEndpointState(t, testEndpointModel(false))}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected no error diagnostics, got: %v", resp.Diagnostics.Errors())
+	}
+	if stub.archiveCalls != 1 {
+		t.Errorf("expected 1 archive call, got %d", stub.archiveCalls)
+	}
+}
+
+func testTagSet(tags ...string) types.Set {
+	if len(tags) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	set, _ := types.SetValueFrom(context.Background(), types.StringType, tags)
+	return set
+}
+
+func TestMergeTags(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		tags     types.Set
+		defaults []string
+		expected types.Set
+	}{
+		{
+			name:     "defaults and resource tags combine",
+			tags:     testTagSet("app"),
+			defaults: []string{"team-a", "prod"},
+			expected: testTagSet("app", "team-a", "prod"),
+		},
+		{
+			name:     "resource tag matching a default is kept once",
+			tags:     testTagSet("prod"),
+			defaults: []string{"prod"},
+			expected: testTagSet("prod"),
+		},
+		{
+			name:     "defaults only",
+			tags:     types.SetNull(types.StringType),
+			defaults: []string{"prod"},
+			expected: testTagSet("prod"),
+		},
+		{
+			name:     "no tags",
+			tags:     types.SetNull(types.StringType),
+			expected: types.SetNull(types.StringType),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, diags := mergeTags(context.Background(), tc.tags, tc.defaults)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !got.Equal(tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestSplitTags(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		tagsAll  []string
+		prior    types.Set
+		expected types.Set
+	}{
+		{
+			name:     "default tags are left out of tags",
+			tagsAll:  []string{"app", "prod"},
+			prior:    testTagSet("app"),
+			expected: testTagSet("app"),
+		},
+		{
+			name:     "default tag also set on the resource is kept",
+			tagsAll:  []string{"app", "prod"},
+			prior:    testTagSet("app", "prod"),
+			expected: testTagSet("app", "prod"),
+		},
+		{
+			name:     "import keeps non-default tags",
+			tagsAll:  []string{"app", "prod"},
+			prior:    types.SetNull(types.StringType),
+			expected: testTagSet("app"),
+		},
+		{
+			name:     "only default tags",
+			tagsAll:  []string{"prod"},
+			prior:    types.SetNull(types.StringType),
+			expected: types.SetNull(types.StringType),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, diags := splitTags(context.Background(), tc.tagsAll, []string{"prod"}, tc.prior)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !got.Equal(tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestEndpointRead_NetworkDrift(t *testing.T) {
+	stub := &endpointLookupStubClient{endpoints: []quicknode.Endpoint{
+		{Id: "endpoint-123", Chain: "eth", Network: "sepolia", HttpUrl: "https://example.quiknode.pro"},
+	}}
+	r := &EndpointResource{client: stub}
+	state := testEndpointState(t, testEndpointModel(false))
+	resp := &fwresource.ReadResponse{State: state}
+
+	r.Read(context.Background(), fwresource.ReadRequest{State: state}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error diagnostics when the server network differs from state")
+	}
+	if got := resp.Diagnostics.Errors()[0].Summary(); got != "Endpoint Network Drift" {
+		t.Errorf("expected summary 'Endpoint Network Drift', got %q", got)
+	}
+	if n := len(resp.Diagnostics.Errors()); n != 1 {
+		t.Errorf("expected only the network to be flagged, got %d errors", n)
+	}
+
+	var data EndpointResourceModel
+	resp.State.Get(context.Background(), &data)
+	if got := data.Network.ValueString(); got != "mainnet" {
+		t.Errorf("expected state to keep network 'mainnet', got %q", got)
+	}
+}
+
+func TestEndpointRead_MixedCaseNetwork(t *testing.T) {
+	stub := &endpointLookupStubClient{endpoints: []quicknode.Endpoint{
+		{Id: "endpoint-123", Chain: "eth", Network: "mainnet", HttpUrl: "https://example.quiknode.pro"},
+	}}
+	r := &EndpointResource{client: stub}
+	model := testEndpointModel(false)
+	model.Chain = types.StringValue("ETH")
+	model.Network = types.StringValue("Mainnet")
+	state := testEndpointState(t, model)
+	resp := &fwresource.ReadResponse{State: state}
+
+	r.Read(context.Background(), fwresource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected no drift for a network differing only in case, got: %v", resp.Diagnostics)
+	}
+
+	var data EndpointResourceModel
+	resp.State.Get(context.Background(), &data)
+	if got := data.Chain.ValueString(); got != "ETH" {
+		t.Errorf("expected state to keep the configured chain 'ETH', got %q", got)
+	}
+	if got := data.Network.ValueString(); got != "Mainnet" {
+		t.Errorf("expected state to keep the configured network 'Mainnet', got %q", got)
+	}
+}
+
+func testChain(slug string, networks ...string) quicknode.Chain {
+	n := make([]quicknode.Network, 0, len(networks))
+	for _, network := range networks {
+		n = append(n, quicknode.Network{Slug: &network})
+	}
+	return quicknode.Chain{Slug: &slug, Networks: &n}
+}
+
+func testChains() []quicknode.Chain {
+	return []quicknode.Chain{
+		testChain("eth", "mainnet", "sepolia"),
+		testChain("sol", "mainnet", "devnet"),
+	}
+}
+
+func TestEndpointModifyPlan_UsesCachedChains(t *testing.T) {
+	// The embedded nil client panics if ModifyPlan fetches chains itself.
+	r := &EndpointResource{client: &archiveStubClient{}, chains: testChains()}
+
+	for _, tc := range []struct {
+		network     string
+		expectError bool
+	}{
+		{"sepolia", false},
+		{"holesky", true},
+	} {
+		data := testEndpointModel(false)
+		data.Network = types.StringValue(tc.network)
+		state := testEndpointState(t, data)
+
+		resp := &fwresource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}}
+		r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+			Plan:   tfsdk.Plan{Schema: state.Schema, Raw: state.Raw},
+		}, resp)
+
+		if resp.Diagnostics.HasError() != tc.expectError {
+			t.Errorf("network %s: expected error %t, got diagnostics: %v", tc.network, tc.expectError, resp.Diagnostics)
+		}
+	}
+}
+
+// importEndpoint imports an endpoint with the given import id against client.
+func importEndpoint(t *testing.T, client quicknode.ClientWithResponsesInterface, id string) *fwresource.ImportStateResponse {
+	t.Helper()
+
+	schema := testEndpointState(t, testEndpointModel(false)).Schema
+	resp := &fwresource.ImportStateResponse{State: tfsdk.State{
+		Schema: schema,
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(context.Background()), nil),
+	}}
+	r := &EndpointResource{client: client}
+	r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: id}, resp)
+
+	return resp
+}
+
+func TestEndpointImportState_ByChainNetwork(t *testing.T) {
+	sepolia := testLookupEndpoint("ep-2", "payments-sepolia")
+	sepolia.Network = "sepolia"
+	stub := &endpointLookupStubClient{endpoints: []quicknode.Endpoint{
+		sepolia,
+		testLookupEndpoint("ep-1", "payments-mainnet"),
+	}}
+
+	resp := importEndpoint(t, stub, "eth/mainnet")
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	var id types.String
+	resp.State.GetAttribute(context.Background(), path.Root("id"), &id)
+	if id.ValueString() != "ep-1" {
+		t.Errorf("expected id ep-1, got %q", id.ValueString())
+	}
+}
+
+func TestEndpointImportState_ById(t *testing.T) {
+	// The embedded nil client panics if an id import lists endpoints.
+	resp := importEndpoint(t, &archiveStubClient{}, "ep-1")
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	var id types.String
+	resp.State.GetAttribute(context.Background(), path.Root("id"), &id)
+	if id.ValueString() != "ep-1" {
+		t.Errorf("expected id ep-1, got %q", id.ValueString())
+	}
+}
+
+func TestEndpointImportState_ChainNetworkErrors(t *testing.T) {
+	stub := &endpointLookupStubClient{endpoints: []quicknode.Endpoint{
+		testLookupEndpoint("ep-1", "payments-mainnet"),
+		testLookupEndpoint("ep-2", "payments-mainnet-old"),
+	}}
+
+	for id, summary := range map[string]string{
+		"eth/mainnet": "Ambiguous Endpoint Network",
+		"eth/sepolia": "Endpoint Not Found",
+		"eth/":        "Invalid Import ID",
+	} {
+		resp := importEndpoint(t, stub, id)
+
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("%s: expected error diagnostics", id)
+			continue
+		}
+		if got := resp.Diagnostics.Errors()[0].Summary(); got != summary {
+			t.Errorf("%s: expected summary %q, got %q", id, summary, got)
+		}
+	}
+}
+
+// labelPatchStubClient creates endpoints and fails every label patch. Any
+// other call panics via the nil embedded interface.
+type labelPatchStubClient struct {
+	quicknode.ClientWithResponsesInterface
+}
+
+func (s *labelPatchStubClient) CreateEndpointWithResponse(_ context.Context, body quicknode.CreateEndpointJSONRequestBody, _ ...quicknode.RequestEditorFn) (*quicknode.CreateEndpointResponse, error) {
+	resp := &quicknode.CreateEndpointResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK, Status: "200 OK"},
+	}
+	resp.JSON200 = &struct {
+		Data  quicknode.SingleEndpoint `json:"data"`
+		Error *string                  `json:"error"`
+	}{Data: quicknode.SingleEndpoint{
+		Id:      "endpoint-123",
+		Chain:   *body.Chain,
+		Network: *body.Network,
+		HttpUrl: "https://example.quiknode.pro/secret-token/",
+	}}
+	return resp, nil
+}
+
+func (s *labelPatchStubClient) UpdateEndpointWithResponse(_ context.Context, _ string, _ quicknode.UpdateEndpointJSONRequestBody, _ ...quicknode.RequestEditorFn) (*quicknode.UpdateEndpointResponse, error) {
+	return &quicknode.UpdateEndpointResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusUnprocessableEntity, Status: "422 Unprocessable Entity"},
+		Body:         []byte(`{"data":null,"error":"label is too long"}`),
+	}, nil
+}
+
+func TestEndpointCreate_LabelPatchFails(t *testing.T) {
+	r := &EndpointResource{client: &labelPatchStubClient{}}
+
+	plan := testEndpointModel(false)
+	plan.Id = types.StringUnknown()
+	plan.Url = types.StringUnknown()
+	plan.Security = types.ObjectUnknown(securityAttributes)
+	plan.Label = types.StringValue("payments-mainnet")
+	planState := testEndpointState(t, plan)
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{
+		Schema: planState.Schema,
+		Raw:    tftypes.NewValue(planState.Schema.Type().TerraformType(context.Background()), nil),
+	}}
+	r.Create(context.Background(), fwresource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: planState.Schema, Raw: planState.Raw},
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error diagnostics when the label patch fails")
+	}
+	diagnostic := resp.Diagnostics.Errors()[0]
+	if got := diagnostic.Summary(); got != "Request Error - Patching Endpoint Label" {
+		t.Errorf("expected summary 'Request Error - Patching Endpoint Label', got %q", got)
+	}
+	if detail := diagnostic.Detail(); !strings.Contains(detail, "422") || !strings.Contains(detail, "label is too long") {
+		t.Errorf("expected the label patch response in the detail, got %q", detail)
+	}
+
+	var data EndpointResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+	if data.Id.ValueString() != "endpoint-123" {
+		t.Errorf("expected the created endpoint to be saved to state, got id %v", data.Id)
+	}
+	if !data.Label.IsNull() {
+		t.Errorf("expected the unapplied label to be left out of state, got %v", data.Label)
+	}
+}
+
+// adoptStubClient lists and shows endpoints like endpointLookupStubClient and
+// records the endpoints it creates.
+type adoptStubClient struct {
+	*endpointLookupStubClient
+
+	created       int
+	createdTags   []string
+	deletedTags   []string
+	updatedLabels []string
+}
+
+func (s *adoptStubClient) UpdateEndpointWithResponse(_ context.Context, _ string, body quicknode.UpdateEndpointJSONRequestBody, _ ...quicknode.RequestEditorFn) (*quicknode.UpdateEndpointResponse, error) {
+	s.updatedLabels = append(s.updatedLabels, *body.Label)
+	return &quicknode.UpdateEndpointResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK, Status: http.StatusText(http.StatusOK)},
+	}, nil
+}
+
+func (s *adoptStubClient) CreateEndpointWithResponse(ctx context.Context, body quicknode.CreateEndpointJSONRequestBody, editors ...quicknode.RequestEditorFn) (*quicknode.CreateEndpointResponse, error) {
+	s.created++
+	return (&labelPatchStubClient{}).CreateEndpointWithResponse(ctx, body, editors...)
+}
+
+func (s *adoptStubClient) CreateTagWithResponse(_ context.Context, _ string, body quicknode.CreateTagJSONRequestBody, _ ...quicknode.RequestEditorFn) (*quicknode.CreateTagResponse, error) {
+	s.createdTags = append(s.createdTags, *body.Label)
+	return &quicknode.CreateTagResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK, Status: http.StatusText(http.StatusOK)},
+	}, nil
+}
+
+func (s *adoptStubClient) DeleteTagWithResponse(_ context.Context, _ string, tagId string, _ ...quicknode.RequestEditorFn) (*quicknode.DeleteTagResponse, error) {
+	s.deletedTags = append(s.deletedTags, tagId)
+	return &quicknode.DeleteTagResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK, Status: http.StatusText(http.StatusOK)},
+	}, nil
+}
+
+func createAdoptingEndpoint(t *testing.T, client quicknode.ClientWithResponsesInterface, tags ...string) (EndpointResourceModel, *fwresource.CreateResponse) {
+	t.Helper()
+
+	plan := testEndpointModel(false)
+	plan.Id = types.StringUnknown()
+	plan.Url = types.StringUnknown()
+	plan.Security = types.ObjectUnknown(securityAttributes)
+	plan.AdoptExisting = types.BoolValue(true)
+	if len(tags) > 0 {
+		set, diags := types.SetValueFrom(context.Background(), types.StringType, tags)
+		if diags.HasError() {
+			t.Fatalf("building tags: %v", diags)
+		}
+		plan.Tags = set
+		plan.TagsAll = set
+	}
+	planState := testEndpointState(t, plan)
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{
+		Schema: planState.Schema,
+		Raw:    tftypes.NewValue(planState.Schema.Type().TerraformType(context.Background()), nil),
+	}}
+	(&EndpointResource{client: client}).Create(context.Background(), fwresource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: planState.Schema, Raw: planState.Raw},
+	}, resp)
+
+	var data EndpointResourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+	}
+	return data, resp
+}
+
+func TestEndpointCreate_AdoptExisting(t *testing.T) {
+	sepolia := testLookupEndpoint("ep-2", "payments-sepolia")
+	sepolia.Network = "sepolia"
+	mainnet := testLookupEndpoint("ep-1", "payments-mainnet")
+	if err := json.Unmarshal([]byte(`[{"label":"payments","tag_id":1},{"label":"legacy","tag_id":2}]`), &mainnet.Tags); err != nil {
+		t.Fatalf("building tags: %v", err)
+	}
+	stub := &adoptStubClient{endpointLookupStubClient: &endpointLookupStubClient{endpoints: []quicknode.Endpoint{
+		sepolia,
+		mainnet,
+	}}}
+
+	data, resp := createAdoptingEndpoint(t, stub, "payments", "prod")
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if stub.created != 0 {
+		t.Errorf("expected the existing endpoint to be adopted, %d were created", stub.created)
+	}
+	if data.Id.ValueString() != "ep-1" {
+		t.Errorf("expected id ep-1, got %v", data.Id)
+	}
+	if data.Url.ValueString() != "https://ep-1.quiknode.pro" {
+		t.Errorf("expected the adopted endpoint's url, got %v", data.Url)
+	}
+	if data.Security.IsNull() || data.Security.IsUnknown() {
+		t.Errorf("expected the adopted endpoint's security, got %v", data.Security)
+	}
+	if !slices.Equal(stub.createdTags, []string{"prod"}) {
+		t.Errorf("expected only the missing tag to be created, got %v", stub.createdTags)
+	}
+	if !slices.Equal(stub.deletedTags, []string{"2"}) {
+		t.Errorf("expected the unconfigured tag to be deleted, got %v", stub.deletedTags)
+	}
+	if !slices.Equal(stub.updatedLabels, []string{""}) {
+		t.Errorf("expected the existing label to be cleared as none is configured, got %v", stub.updatedLabels)
+	}
+	if !data.Label.IsNull() {
+		t.Errorf("expected no label in state, got %v", data.Label)
+	}
+}
+
+func TestEndpointCreate_AdoptExistingNone(t *testing.T) {
+	sepolia := testLookupEndpoint("ep-2", "payments-sepolia")
+	sepolia.Network = "sepolia"
+	stub := &adoptStubClient{endpointLookupStubClient: &endpointLookupStubClient{endpoints: []quicknode.Endpoint{sepolia}}}
+
+	data, resp := createAdoptingEndpoint(t, stub)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if stub.created != 1 {
+		t.Errorf("expected an endpoint to be created, %d were", stub.created)
+	}
+	if data.Id.ValueString() != "endpoint-123" {
+		t.Errorf("expected the created endpoint's id, got %v", data.Id)
+	}
+	if len(stub.updatedLabels) != 0 {
+		t.Errorf("expected no label update for a new endpoint without a label, got %v", stub.updatedLabels)
+	}
+}
+
+func TestEndpointCreate_AdoptExistingAmbiguous(t *testing.T) {
+	stub := &adoptStubClient{endpointLookupStubClient: &endpointLookupStubClient{endpoints: []quicknode.Endpoint{
+		testLookupEndpoint("ep-1", "payments-mainnet"),
+		testLookupEndpoint("ep-2", "payments-mainnet-old"),
+	}}}
+
+	_, resp := createAdoptingEndpoint(t, stub)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error diagnostics")
+	}
+	if got := resp.Diagnostics.Errors()[0].Summary(); got != "Ambiguous Endpoint Network" {
+		t.Errorf("expected summary 'Ambiguous Endpoint Network', got %q", got)
+	}
+	if stub.created != 0 {
+		t.Errorf("expected no endpoint to be created, %d were", stub.created)
+	}
+}
+
+func TestAccQuicknodeEndpointResource_RateLimits(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuickNodeResourceRateLimits(rName, "rps = 10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_endpoint.main", "rate_limits.rps", "10"),
+					resource.TestCheckNoResourceAttr("quicknode_endpoint.main", "rate_limits.rpm"),
+				),
+			},
+			{
+				Config: testAccQuickNodeResourceRateLimits(rName, "rps = 20\n\t\trpm = 600"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_endpoint.main", "rate_limits.rps", "20"),
+					resource.TestCheckResourceAttr("quicknode_endpoint.main", "rate_limits.rpm", "600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccQuickNodeResourceRateLimits(name, limits string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "quicknode_endpoint" "main" {
+	network = "mainnet"
+	chain   = "eth"
+	label   = "%s-rate-limits"
+	rate_limits = {
+		%s
+	}
+}`, name, limits)
+}
+
+// rateLimitsStubClient records rate limit updates; any other call panics via
+// the nil embedded interface.
+type rateLimitsStubClient struct {
+	quicknode.ClientWithResponsesInterface
+
+	bodies []quicknode.UpdateRateLimitsJSONRequestBody
+}
+
+func (s *rateLimitsStubClient) UpdateRateLimitsWithResponse(_ context.Context, _ string, body quicknode.UpdateRateLimitsJSONRequestBody, _ ...quicknode.RequestEditorFn) (*quicknode.UpdateRateLimitsResponse, error) {
+	s.bodies = append(s.bodies, body)
+	return &quicknode.UpdateRateLimitsResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK, Status: "200 OK"},
+		Body:         []byte(`{}`),
+	}, nil
+}
+
+func testRateLimits(t *testing.T, rps, rpm, rpd types.Int64) types.Object {
+	t.Helper()
+
+	value, diags := types.ObjectValueFrom(context.Background(), rateLimitsAttributes, EndpointResourceRateLimits{Rps: rps, Rpm: rpm, Rpd: rpd})
+	if diags.HasError() {
+		t.Fatalf("building rate_limits: %v", diags)
+	}
+	return value
+}
+
+func TestSetRateLimits(t *testing.T) {
+	stub := &rateLimitsStubClient{}
+	r := &EndpointResource{client: stub}
+	var diags diag.Diagnostics
+
+	r.setRateLimits(context.Background(), "endpoint-123", testRateLimits(t, types.Int64Value(10), types.Int64Null(), types.Int64Value(100000)), &diags)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(stub.bodies) != 1 {
+		t.Fatalf("expected 1 rate limit update, got %d", len(stub.bodies))
+	}
+	limits := stub.bodies[0].RateLimits
+	if limits.Rps == nil || *limits.Rps != 10 || limits.Rpd == nil || *limits.Rpd != 100000 {
+		t.Errorf("expected rps 10 and rpd 100000 to be sent, got %+v", limits)
+	}
+	if limits.Rpm != nil {
+		t.Errorf("expected rpm to be left out, got %d", *limits.Rpm)
+	}
+}
+
+func TestSetRateLimits_NoLimits(t *testing.T) {
+	stub := &rateLimitsStubClient{}
+	r := &EndpointResource{client: stub}
+	var diags diag.Diagnostics
+
+	r.setRateLimits(context.Background(), "endpoint-123", testRateLimits(t, types.Int64Null(), types.Int64Null(), types.Int64Null()), &diags)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(stub.bodies) != 0 {
+		t.Errorf("expected no rate limit update without limits, got %d", len(stub.bodies))
+	}
+}
+
+func TestReadRateLimits(t *testing.T) {
+	rps, rpm, rpd := 25, 1500, 2000000
+	read := &quicknode.EndpointRateLimits{Rps: &rps, Rpm: &rpm, Rpd: &rpd}
+
+	value, diags := readRateLimits(context.Background(), testRateLimits(t, types.Int64Value(10), types.Int64Value(600), types.Int64Null()), read)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	expected := testRateLimits(t, types.Int64Value(25), types.Int64Value(1500), types.Int64Null())
+	if !value.Equal(expected) {
+		t.Errorf("expected configured limits to be refreshed and rpd left null, got %v", value)
+	}
+
+	value, _ = readRateLimits(context.Background(), types.ObjectNull(rateLimitsAttributes), read)
+	if !value.IsNull() {
+		t.Errorf("expected plan limits to be left out of state without rate_limits, got %v", value)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &EndpointUrlFunction{}
+
+// EndpointUrlFunction rebuilds the connection URL of an endpoint from the
+// trimmed url the quicknode_endpoint resource stores and one of its tokens.
+type EndpointUrlFunction struct{}
+
+func NewEndpointUrlFunction() function.Function {
+	return &EndpointUrlFunction{}
+}
+
+func (f *EndpointUrlFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "endpoint_url"
+}
+
+func (f *EndpointUrlFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds the connection URL of an endpoint",
+		MarkdownDescription: "Returns the URL to connect to an endpoint with a token, such as `https://example.quiknode.pro/abc123/`. " +
+			"Use it with the `url` and a `security.tokens` entry of a `quicknode_endpoint`, such as " +
+			"`provider::quicknode::endpoint_url(quicknode_endpoint.main.url, quicknode_endpoint.main.security.tokens[0].token)`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: "Endpoint URL without a path, with scheme `http`, `https`, `ws` or `wss`",
+			},
+			function.StringParameter{
+				Name:                "token",
+				MarkdownDescription: "Token to authenticate with the endpoint",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// endpointUrlSchemes are the schemes an endpoint can be connected to with.
+var endpointUrlSchemes = []string{"http", "https", "ws", "wss"}
+
+func (f *EndpointUrlFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rawURL, token string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &rawURL, &token))
+	if resp.Error != nil {
+		return
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || !slices.Contains(endpointUrlSchemes, u.Scheme) || u.Host == "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Expected url to be an endpoint URL with scheme one of %v, but was %s", endpointUrlSchemes, rawURL))
+		return
+	}
+	if strings.Trim(u.Path, "/") != "" || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Expected url to have no path, query or credentials, but was %s", rawURL))
+		return
+	}
+
+	if token == "" || strings.ContainsAny(token, "/?#@ ") {
+		resp.Error = function.NewArgumentFuncError(1, "Expected token to be a non-empty endpoint token without /, ?, #, @ or spaces")
+		return
+	}
+
+	result := fmt.Sprintf("%s://%s/%s/", u.Scheme, u.Host, token)
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runEndpointUrl(rawURL, token string) function.RunResponse {
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewEndpointUrlFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(rawURL), types.StringValue(token)}),
+	}, &resp)
+	return resp
+}
+
+func TestEndpointUrlFunction(t *testing.T) {
+	for rawURL, expected := range map[string]string{
+		"https://example.quiknode.pro":  "https://example.quiknode.pro/abc123/",
+		"https://example.quiknode.pro/": "https://example.quiknode.pro/abc123/",
+		"wss://example.quiknode.pro":    "wss://example.quiknode.pro/abc123/",
+	} {
+		resp := runEndpointUrl(rawURL, "abc123")
+		if resp.Error != nil {
+			t.Fatalf("%s: unexpected error: %s", rawURL, resp.Error)
+		}
+
+		got := resp.Result.Value().(types.String).ValueString()
+		if got != expected {
+			t.Errorf("%s: expected %q, got %q", rawURL, expected, got)
+		}
+
+		u, err := url.Parse(got)
+		if err != nil || u.Host != "example.quiknode.pro" || u.Path != "/abc123/" {
+			t.Errorf("%s: expected a well-formed URL with the token as its path, got %q", rawURL, got)
+		}
+	}
+}
+
+func TestEndpointUrlFunction_Invalid(t *testing.T) {
+	for _, tc := range []struct {
+		url, token string
+		argument   int64
+	}{
+		{"example.quiknode.pro", "abc123", 0},
+		{"ftp://example.quiknode.pro", "abc123", 0},
+		{"https://example.quiknode.pro/other-token/", "abc123", 0},
+		{"https://example.quiknode.pro", "", 1},
+		{"https://example.quiknode.pro", "abc/123", 1},
+	} {
+		resp := runEndpointUrl(tc.url, tc.token)
+		if resp.Error == nil {
+			t.Errorf("%s %q: expected an error", tc.url, tc.token)
+			continue
+		}
+		if resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != tc.argument {
+			t.Errorf("%s %q: expected an error for argument %d, got %s", tc.url, tc.token, tc.argument, resp.Error)
+		}
+	}
+}
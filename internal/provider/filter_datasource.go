@@ -20,10 +20,15 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -36,10 +41,26 @@ import (
 // - Validation of file content
 // - Debugging capabilities (shows raw code in output)
 // - Future extensibility for advanced features
+// - Fetching filters published at an http(s) URL
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &FilterDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &FilterDataSource{}
+)
+
+const (
+	// filterDownloadTimeout bounds fetching a filter from url.
+	filterDownloadTimeout = 30 * time.Second
+
+	// filterDownloadMaxBytes is the largest filter fetched from url.
+	filterDownloadMaxBytes = 1 << 20
+)
 
 // FilterDataSourceModel describes the data structure.
 type FilterDataSourceModel struct {
 	FilePath      types.String `tfsdk:"file_path"`
+	Url           types.String `tfsdk:"url"`
 	FilterCode    types.String `tfsdk:"filter_code"`
 	Base64Encoded types.String `tfsdk:"base64_encoded"`
 }
@@ -55,11 +76,15 @@ func (d *FilterDataSource) Metadata(ctx context.Context, req datasource.Metadata
 // Schema defines the schema for the data source.
 func (d *FilterDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Data source for QuickNode Stream filters",
+		MarkdownDescription: "Data source for QuickNode Stream filters, read from a local file or fetched from a URL",
 		Attributes: map[string]schema.Attribute{
 			"file_path": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Path to JavaScript filter file",
+				Optional:            true,
+				MarkdownDescription: "Path to JavaScript filter file. Exactly one of `file_path` and `url` must be set.",
+			},
+			"url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "http or https URL to fetch the JavaScript filter from. The filter must be at most 1 MiB and be served within 30 seconds.",
 			},
 			"filter_code": schema.StringAttribute{
 				Computed:            true,
@@ -73,6 +98,41 @@ func (d *FilterDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 	}
 }
 
+func (d *FilterDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data FilterDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.FilePath.IsUnknown() || data.Url.IsUnknown() {
+		return
+	}
+
+	if data.FilePath.IsNull() == data.Url.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("url"),
+			"Invalid Filter Source",
+			"Exactly one of file_path and url must be set",
+		)
+		return
+	}
+
+	if data.Url.IsNull() {
+		return
+	}
+
+	u, err := url.Parse(data.Url.ValueString())
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("url"),
+			"Invalid URL",
+			fmt.Sprintf("Expected an http or https URL, got: %s", data.Url.ValueString()),
+		)
+	}
+}
+
 // Read reads the data source.
 func (d *FilterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data FilterDataSourceModel
@@ -83,11 +143,22 @@ func (d *FilterDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	// Read file content
-	fileContent, err := os.ReadFile(data.FilePath.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading filter file", fmt.Sprintf("Could not read file %s: %v", data.FilePath.ValueString(), err))
-		return
+	var fileContent []byte
+	if !data.Url.IsNull() {
+		content, err := fetchFilter(ctx, data.Url.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("url"), "Error fetching filter", fmt.Sprintf("Could not fetch filter from %s: %v", data.Url.ValueString(), err))
+			return
+		}
+		fileContent = content
+	} else {
+		// Read file content
+		content, err := os.ReadFile(data.FilePath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading filter file", fmt.Sprintf("Could not read file %s: %v", data.FilePath.ValueString(), err))
+			return
+		}
+		fileContent = content
 	}
 
 	// Set filter code
@@ -101,6 +172,38 @@ func (d *FilterDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// fetchFilter downloads the filter at rawURL, failing on a non-2xx response,
+// after filterDownloadTimeout or when it exceeds filterDownloadMaxBytes.
+func fetchFilter(ctx context.Context, rawURL string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, filterDownloadTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("unexpected response %s", resp.Status)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, filterDownloadMaxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > filterDownloadMaxBytes {
+		return nil, fmt.Errorf("filter is larger than %d bytes", filterDownloadMaxBytes)
+	}
+
+	return content, nil
+}
+
 // NewFilterDataSource returns a new instance of the data source.
 func NewFilterDataSource() datasource.DataSource {
 	return &FilterDataSource{}
@@ -0,0 +1,133 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testFilterConfig(t *testing.T, filePath, url types.String) tfsdk.Config {
+	t.Helper()
+
+	var schemaResp datasource.SchemaResponse
+	NewFilterDataSource().Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil)}
+	if diags := state.Set(context.Background(), &FilterDataSourceModel{
+		FilePath:      filePath,
+		Url:           url,
+		FilterCode:    types.StringNull(),
+		Base64Encoded: types.StringNull(),
+	}); diags.HasError() {
+		t.Fatalf("building config: %v", diags)
+	}
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}
+}
+
+func readFilterDataSource(t *testing.T, url string) (FilterDataSourceModel, *datasource.ReadResponse) {
+	t.Helper()
+
+	config := testFilterConfig(t, types.StringNull(), types.StringValue(url))
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: config.Schema, Raw: tftypes.NewValue(config.Schema.Type().TerraformType(context.Background()), nil)},
+	}
+	NewFilterDataSource().Read(context.Background(), datasource.ReadRequest{Config: config}, resp)
+
+	var result FilterDataSourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &result)...)
+	}
+	return result, resp
+}
+
+func TestFilterDataSource_Url(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("function main(stream) { return stream; }"))
+	}))
+	defer server.Close()
+
+	result, resp := readFilterDataSource(t, server.URL+"/filter.js")
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !result.FilterCode.Equal(types.StringValue("function main(stream) { return stream; }")) {
+		t.Errorf("expected the fetched filter, got %v", result.FilterCode)
+	}
+	if !result.Base64Encoded.Equal(types.StringValue("ZnVuY3Rpb24gbWFpbihzdHJlYW0pIHsgcmV0dXJuIHN0cmVhbTsgfQ==")) {
+		t.Errorf("expected the fetched filter base64 encoded, got %v", result.Base64Encoded)
+	}
+}
+
+func TestFilterDataSource_UrlErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/large.js" {
+			_, _ = w.Write([]byte(strings.Repeat("a", filterDownloadMaxBytes+1)))
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	for path, detail := range map[string]string{
+		"/missing.js": "404 Not Found",
+		"/large.js":   "filter is larger than",
+	} {
+		_, resp := readFilterDataSource(t, server.URL+path)
+
+		if !resp.Diagnostics.HasError() {
+			t.Fatalf("%s: expected error diagnostics", path)
+		}
+		if got := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(got, detail) {
+			t.Errorf("%s: expected diagnostic detail containing %q, got %q", path, detail, got)
+		}
+	}
+}
+
+func TestFilterDataSource_ValidateConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		filePath    types.String
+		url         types.String
+		expectError bool
+	}{
+		{name: "file_path", filePath: types.StringValue("filter.js"), url: types.StringNull()},
+		{name: "https url", filePath: types.StringNull(), url: types.StringValue("https://example.com/filter.js")},
+		{name: "unknown url", filePath: types.StringNull(), url: types.StringUnknown()},
+		{name: "neither", filePath: types.StringNull(), url: types.StringNull(), expectError: true},
+		{name: "both", filePath: types.StringValue("filter.js"), url: types.StringValue("https://example.com/filter.js"), expectError: true},
+		{name: "file url", filePath: types.StringNull(), url: types.StringValue("file:///etc/filter.js"), expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &datasource.ValidateConfigResponse{}
+			(&FilterDataSource{}).ValidateConfig(context.Background(), datasource.ValidateConfigRequest{Config: testFilterConfig(t, tc.filePath, tc.url)}, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
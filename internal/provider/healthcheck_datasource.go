@@ -0,0 +1,291 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/circlefin/terraform-provider-quicknode/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// HealthcheckDataSource sends a JSON-RPC request for the latest block to an
+// endpoint, so configurations can assert an endpoint is live in check blocks.
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &HealthcheckDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &HealthcheckDataSource{}
+)
+
+const (
+	// defaultHealthcheckTimeoutSec bounds the probe when timeout_sec is unset.
+	defaultHealthcheckTimeoutSec = 10
+
+	// healthcheckMaxResponseBytes is the largest probe response read.
+	healthcheckMaxResponseBytes = 1 << 20
+)
+
+// healthcheckMethods maps endpoint chains to the JSON-RPC method returning
+// their latest block. Other chains are probed with the configured method.
+var healthcheckMethods = map[string]string{
+	"eth":  "eth_blockNumber",
+	"sol":  "getSlot",
+	"btc":  "getblockcount",
+	"bch":  "getblockcount",
+	"ltc":  "getblockcount",
+	"doge": "getblockcount",
+	"zec":  "getblockcount",
+}
+
+// HealthcheckDataSourceModel describes the data structure.
+type HealthcheckDataSourceModel struct {
+	Url         types.String `tfsdk:"url"`
+	Chain       types.String `tfsdk:"chain"`
+	TimeoutSec  types.Int64  `tfsdk:"timeout_sec"`
+	Method      types.String `tfsdk:"method"`
+	BlockNumber types.Int64  `tfsdk:"block_number"`
+	LatencyMs   types.Int64  `tfsdk:"latency_ms"`
+}
+
+// HealthcheckDataSource implements datasource.DataSource.
+type HealthcheckDataSource struct{}
+
+// Metadata returns the data source type name.
+func (d *HealthcheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_healthcheck"
+}
+
+// Schema defines the schema for the data source.
+func (d *HealthcheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Probes an endpoint with a JSON-RPC request for its latest block. Reading fails when the endpoint does not answer with a block number, so use it in a `check` block to assert an endpoint is live.",
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "http or https URL of the endpoint including its token, such as built by the `endpoint_url` function",
+			},
+			"chain": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Chain of the endpoint, used to pick the probe when `method` is unset. `eth` is probed with `eth_blockNumber`, `sol` with `getSlot`, and `btc`, `bch`, `ltc`, `doge` and `zec` with `getblockcount`. Other chains need `method`.",
+			},
+			"timeout_sec": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Seconds to wait for the endpoint to answer, between 1 and 300. Defaults to %d.", defaultHealthcheckTimeoutSec),
+				Validators: []validator.Int64{
+					validators.HealthcheckTimeoutSecValidator,
+				},
+			},
+			"method": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "JSON-RPC method, taking no parameters, that returns the latest block as a number or hex string, such as `eth_blockNumber` for EVM chains. Defaults to the probe of `chain`.",
+			},
+			"block_number": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Latest block, or slot for Solana, reported by the endpoint",
+			},
+			"latency_ms": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Milliseconds the endpoint took to answer the probe",
+			},
+		},
+	}
+}
+
+func (d *HealthcheckDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data HealthcheckDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Chain.IsUnknown() && !data.Method.IsUnknown() {
+		if _, err := healthcheckMethod(data); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("method"), "Missing Probe Method", err.Error())
+		}
+	}
+
+	if data.Url.IsNull() || data.Url.IsUnknown() {
+		return
+	}
+
+	u, err := url.Parse(data.Url.ValueString())
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("url"),
+			"Invalid URL",
+			"Expected an http or https URL",
+		)
+	}
+}
+
+// Read reads the data source.
+func (d *HealthcheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HealthcheckDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	method, err := healthcheckMethod(data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("method"), "Missing Probe Method", err.Error())
+		return
+	}
+
+	timeout := time.Duration(defaultHealthcheckTimeoutSec) * time.Second
+	if !data.TimeoutSec.IsNull() {
+		timeout = time.Duration(data.TimeoutSec.ValueInt64()) * time.Second
+	}
+
+	start := time.Now()
+	blockNumber, err := probeEndpoint(ctx, data.Url.ValueString(), method, timeout)
+	if err != nil {
+		// The URL holds the endpoint token, so it is left out of the error.
+		resp.Diagnostics.AddError("Endpoint Unhealthy", fmt.Sprintf("The endpoint did not answer %s: %v", method, err))
+		return
+	}
+
+	data.Method = types.StringValue(method)
+	data.BlockNumber = types.Int64Value(blockNumber)
+	data.LatencyMs = types.Int64Value(time.Since(start).Milliseconds())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// healthcheckMethod returns the configured method, or else the probe of the
+// configured chain. Chains without a known probe are an error rather than
+// being assumed to speak the Ethereum JSON-RPC API.
+func healthcheckMethod(data HealthcheckDataSourceModel) (string, error) {
+	if !data.Method.IsNull() {
+		return data.Method.ValueString(), nil
+	}
+
+	if data.Chain.IsNull() {
+		return "", fmt.Errorf("set chain or method to choose the JSON-RPC request the endpoint is probed with")
+	}
+
+	method, ok := healthcheckMethods[strings.ToLower(data.Chain.ValueString())]
+	if !ok {
+		return "", fmt.Errorf("unsupported chain %q, set method to a JSON-RPC method returning its latest block", data.Chain.ValueString())
+	}
+	return method, nil
+}
+
+// probeEndpoint sends method to the endpoint at rawURL and returns the block
+// number it answers with, failing after timeout.
+func probeEndpoint(ctx context.Context, rawURL string, method string, timeout time.Duration) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	body, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  method,
+		"params":  []interface{}{},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rawURL, bytes.NewReader(body))
+	if err != nil {
+		// url.Error includes the URL, and with it the endpoint token.
+		return 0, fmt.Errorf("invalid request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return 0, fmt.Errorf("unexpected response %s", resp.Status)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, healthcheckMaxResponseBytes))
+	if err != nil {
+		return 0, err
+	}
+
+	var rpcResp struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(content, &rpcResp); err != nil {
+		return 0, fmt.Errorf("invalid JSON-RPC response: %v", err)
+	}
+	if rpcResp.Error != nil {
+		return 0, fmt.Errorf("JSON-RPC error %d: %s", rpcResp.Error.Code, rpcResp.Error.Message)
+	}
+
+	return parseBlockNumber(rpcResp.Result)
+}
+
+// parseBlockNumber parses the result of a probe, either a hex string such as
+// eth_blockNumber returns or a JSON number.
+func parseBlockNumber(result json.RawMessage) (int64, error) {
+	var s string
+	if err := json.Unmarshal(result, &s); err == nil && bytes.HasPrefix(bytes.TrimSpace(result), []byte(`"`)) {
+		n, err := strconv.ParseInt(strings.TrimPrefix(s, "0x"), 16, 64)
+		if err != nil || !strings.HasPrefix(s, "0x") {
+			return 0, fmt.Errorf("expected a hex block number, got: %s", s)
+		}
+		return n, nil
+	}
+
+	// A null result decodes to a nil pointer rather than failing.
+	var n *int64
+	if err := json.Unmarshal(result, &n); err != nil || n == nil {
+		return 0, fmt.Errorf("expected a block number, got: %s", result)
+	}
+	return *n, nil
+}
+
+// NewHealthcheckDataSource returns a new instance of the data source.
+func NewHealthcheckDataSource() datasource.DataSource {
+	return &HealthcheckDataSource{}
+}
@@ -0,0 +1,180 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testRPCServer answers each JSON-RPC method in results with its raw result,
+// and any other method with a method not found error.
+func testRPCServer(t *testing.T, results map[string]string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if result, ok := results[req.Method]; ok {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":` + result + `}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"the method ` + req.Method + ` does not exist"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func readHealthcheckDataSource(t *testing.T, url string, chain, method types.String, timeoutSec types.Int64) (HealthcheckDataSourceModel, *datasource.ReadResponse) {
+	t.Helper()
+
+	var schemaResp datasource.SchemaResponse
+	NewHealthcheckDataSource().Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil)}
+	if diags := config.Set(context.Background(), &HealthcheckDataSourceModel{
+		Url:         types.StringValue(url),
+		Chain:       chain,
+		TimeoutSec:  timeoutSec,
+		Method:      method,
+		BlockNumber: types.Int64Null(),
+		LatencyMs:   types.Int64Null(),
+	}); diags.HasError() {
+		t.Fatalf("building config: %v", diags)
+	}
+
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil)},
+	}
+	NewHealthcheckDataSource().Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, resp)
+
+	var result HealthcheckDataSourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &result)...)
+	}
+	return result, resp
+}
+
+func TestHealthcheckDataSource_Read(t *testing.T) {
+	server := testRPCServer(t, map[string]string{
+		"eth_blockNumber": `"0x1312d00"`,
+		"getSlot":         `312000000`,
+		"getblockcount":   `870000`,
+	})
+
+	for _, tc := range []struct {
+		chain       types.String
+		config      types.String
+		method      string
+		blockNumber int64
+	}{
+		{chain: types.StringValue("eth"), config: types.StringNull(), method: "eth_blockNumber", blockNumber: 20000000},
+		{chain: types.StringValue("base"), config: types.StringValue("eth_blockNumber"), method: "eth_blockNumber", blockNumber: 20000000},
+		{chain: types.StringNull(), config: types.StringValue("getblockcount"), method: "getblockcount", blockNumber: 870000},
+		{chain: types.StringValue("sol"), config: types.StringNull(), method: "getSlot", blockNumber: 312000000},
+		{chain: types.StringValue("BTC"), config: types.StringNull(), method: "getblockcount", blockNumber: 870000},
+	} {
+		result, resp := readHealthcheckDataSource(t, server.URL+"/abc123/", tc.chain, tc.config, types.Int64Null())
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %v", tc.chain, resp.Diagnostics)
+		}
+		if !result.Method.Equal(types.StringValue(tc.method)) {
+			t.Errorf("%s: expected method %q, got %v", tc.chain, tc.method, result.Method)
+		}
+		if !result.BlockNumber.Equal(types.Int64Value(tc.blockNumber)) {
+			t.Errorf("%s: expected block_number %d, got %v", tc.chain, tc.blockNumber, result.BlockNumber)
+		}
+		if result.LatencyMs.IsNull() || result.LatencyMs.ValueInt64() < 0 {
+			t.Errorf("%s: expected latency_ms to be set, got %v", tc.chain, result.LatencyMs)
+		}
+	}
+}
+
+func TestHealthcheckDataSource_Unhealthy(t *testing.T) {
+	server := testRPCServer(t, map[string]string{"eth_blockNumber": `null`})
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer unavailable.Close()
+
+	for _, tc := range []struct {
+		name   string
+		url    string
+		chain  types.String
+		detail string
+	}{
+		{name: "rpc error", url: server.URL, chain: types.StringValue("sol"), detail: "JSON-RPC error -32601"},
+		{name: "no block number", url: server.URL, chain: types.StringValue("eth"), detail: "expected a block number"},
+		{name: "unavailable", url: unavailable.URL, chain: types.StringValue("eth"), detail: "503 Service Unavailable"},
+		{name: "unsupported chain", url: server.URL, chain: types.StringValue("xrp"), detail: `unsupported chain "xrp", set method`},
+		{name: "no chain or method", url: server.URL, chain: types.StringNull(), detail: "set chain or method"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, resp := readHealthcheckDataSource(t, tc.url, tc.chain, types.StringNull(), types.Int64Null())
+
+			if !resp.Diagnostics.HasError() {
+				t.Fatalf("expected error diagnostics")
+			}
+			if got := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(got, tc.detail) {
+				t.Errorf("expected diagnostic detail containing %q, got %q", tc.detail, got)
+			}
+		})
+	}
+}
+
+func TestHealthcheckDataSource_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	start := time.Now()
+	_, resp := readHealthcheckDataSource(t, server.URL+"/secret-token/", types.StringValue("eth"), types.StringNull(), types.Int64Value(1))
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error diagnostics")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the probe to time out after 1s, took %s", elapsed)
+	}
+	if got := resp.Diagnostics.Errors()[0].Detail(); strings.Contains(got, "secret-token") {
+		t.Errorf("expected the diagnostic to leave out the endpoint token, got %q", got)
+	}
+}
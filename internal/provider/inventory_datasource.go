@@ -0,0 +1,291 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/circlefin/terraform-provider-quicknode/api/quicknode"
+	"github.com/circlefin/terraform-provider-quicknode/api/streams"
+	"github.com/circlefin/terraform-provider-quicknode/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// InventoryDataSource lists every endpoint and stream of the account, so
+// import blocks can be generated when adopting existing resources.
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &InventoryDataSource{}
+	_ datasource.DataSourceWithConfigure = &InventoryDataSource{}
+
+	inventoryEndpointAttributes = map[string]attr.Type{
+		"id":      types.StringType,
+		"chain":   types.StringType,
+		"network": types.StringType,
+		"label":   types.StringType,
+	}
+	inventoryStreamAttributes = map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"network":     types.StringType,
+		"dataset":     types.StringType,
+		"destination": types.StringType,
+	}
+)
+
+// InventoryDataSourceModel describes the data structure.
+type InventoryDataSourceModel struct {
+	Endpoints types.List `tfsdk:"endpoints"`
+	Streams   types.List `tfsdk:"streams"`
+}
+
+type InventoryEndpointModel struct {
+	Id      types.String `tfsdk:"id"`
+	Chain   types.String `tfsdk:"chain"`
+	Network types.String `tfsdk:"network"`
+	Label   types.String `tfsdk:"label"`
+}
+
+type InventoryStreamModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Network     types.String `tfsdk:"network"`
+	Dataset     types.String `tfsdk:"dataset"`
+	Destination types.String `tfsdk:"destination"`
+}
+
+// InventoryDataSource implements datasource.DataSource.
+type InventoryDataSource struct {
+	client        quicknode.ClientWithResponsesInterface
+	streamsClient streams.ClientWithResponsesInterface
+
+	verboseErrors bool
+}
+
+// Metadata returns the data source type name.
+func (d *InventoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inventory"
+}
+
+// Schema defines the schema for the data source.
+func (d *InventoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the endpoints and streams of the account. The `id` of each entry is its import ID, so the inventory can be used to generate `import` blocks. " +
+			"If the API key cannot access Streams, a warning is shown and `streams` is null.",
+		Attributes: map[string]schema.Attribute{
+			"endpoints": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Endpoints of the account",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "ID of the endpoint, used to import it as a `quicknode_endpoint`",
+						},
+						"chain": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Chain of the endpoint",
+						},
+						"network": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Network of the endpoint",
+						},
+						"label": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Label of the endpoint",
+						},
+					},
+				},
+			},
+			"streams": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Streams of the account",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "ID of the stream, used to import it as a `quicknode_stream`",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the stream",
+						},
+						"network": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Network of the stream",
+						},
+						"dataset": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Dataset of the stream",
+						},
+						"destination": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Destination of the stream",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InventoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	qnd, ok := req.ProviderData.(QuickNodeData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData type",
+			fmt.Sprintf("Expected QuickNodeData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = qnd.Client
+	d.streamsClient = qnd.StreamsClient
+	d.verboseErrors = qnd.VerboseErrors
+}
+
+// Read reads the data source.
+func (d *InventoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	data := InventoryDataSourceModel{
+		Endpoints: types.ListNull(types.ObjectType{AttrTypes: inventoryEndpointAttributes}),
+		Streams:   types.ListNull(types.ObjectType{AttrTypes: inventoryStreamAttributes}),
+	}
+
+	endpoints, diags := listEndpoints(ctx, d.client, quicknode.ListEndpointsParams{}, d.verboseErrors)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpointModels := make([]InventoryEndpointModel, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		endpointModels = append(endpointModels, InventoryEndpointModel{
+			Id:      types.StringValue(endpoint.Id),
+			Chain:   types.StringValue(endpoint.Chain),
+			Network: types.StringValue(endpoint.Network),
+			Label:   types.StringPointerValue(endpoint.Label),
+		})
+	}
+	data.Endpoints, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: inventoryEndpointAttributes}, endpointModels)
+	resp.Diagnostics.Append(diags...)
+
+	streamList, available, diags := listStreams(ctx, d.streamsClient, d.verboseErrors)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if available {
+		streamModels := make([]InventoryStreamModel, 0, len(streamList))
+		for _, stream := range streamList {
+			streamModels = append(streamModels, InventoryStreamModel{
+				Id:          inventoryString(stream, "id"),
+				Name:        inventoryString(stream, "name"),
+				Network:     inventoryString(stream, "network"),
+				Dataset:     inventoryString(stream, "dataset"),
+				Destination: inventoryString(stream, "destination"),
+			})
+		}
+		data.Streams, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: inventoryStreamAttributes}, streamModels)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// inventoryString returns the string field of a listed stream, or null when
+// the field is absent or empty.
+func inventoryString(stream map[string]interface{}, field string) types.String {
+	if value, ok := stream[field].(string); ok && value != "" {
+		return types.StringValue(value)
+	}
+	return types.StringNull()
+}
+
+// streamListPageSize is the number of streams listStreams requests per page.
+const streamListPageSize = 100
+
+// listStreams returns every stream of the account, paging until a page comes
+// back short. available is false, with a warning, when the API key cannot
+// access Streams.
+func listStreams(ctx context.Context, client streams.ClientWithResponsesInterface, verboseErrors bool) (result []map[string]interface{}, available bool, diags diag.Diagnostics) {
+	for offset := 0; ; {
+		listResp, err := client.FindAllWithResponse(ctx, &streams.FindAllParams{
+			Limit:  streamListPageSize,
+			Offset: float32(offset),
+		})
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("%s - Listing Streams", utils.ClientErrorSummary),
+				utils.BuildClientErrorMessage(err),
+			)
+			return nil, false, diags
+		}
+
+		switch listResp.StatusCode() {
+		case http.StatusOK:
+		case http.StatusUnauthorized, http.StatusForbidden:
+			diags.AddWarning(
+				"Streams Unavailable",
+				fmt.Sprintf("The API key cannot list streams (%s), so streams is left null.", listResp.Status()),
+			)
+			return nil, false, diags
+		default:
+			m, err := utils.BuildRequestErrorMessage(listResp.Status(), listResp.Body, verboseErrors)
+			if err != nil {
+				diags.AddWarning(fmt.Sprintf("%s - Listing Streams", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
+			}
+
+			diags.AddError(
+				fmt.Sprintf("%s - Listing Streams", utils.RequestErrorSummary),
+				m,
+			)
+			return nil, false, diags
+		}
+
+		// The spec leaves the response undocumented; it is a list of streams,
+		// bare or in a {"data": [...]} envelope.
+		var page []map[string]interface{}
+		if err := json.Unmarshal(unwrapDataEnvelope(listResp.Body), &page); err != nil {
+			diags.AddError("Error parsing response", fmt.Sprintf("Could not parse streams from API: %v", err))
+			return nil, false, diags
+		}
+
+		result = append(result, page...)
+		if len(page) < streamListPageSize {
+			return result, true, diags
+		}
+		offset += len(page)
+	}
+}
+
+// NewInventoryDataSource returns a new instance of the data source.
+func NewInventoryDataSource() datasource.DataSource {
+	return &InventoryDataSource{}
+}
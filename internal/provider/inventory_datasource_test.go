@@ -0,0 +1,142 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/circlefin/terraform-provider-quicknode/api/quicknode"
+	"github.com/circlefin/terraform-provider-quicknode/api/streams"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// streamListStubClient serves streams in pages of the requested size, inside
+// a {"data": [...]} envelope. Any other call panics via the nil embedded
+// interface.
+type streamListStubClient struct {
+	streams.ClientWithResponsesInterface
+
+	status  int
+	streams []map[string]interface{}
+	offsets []float32
+}
+
+func (s *streamListStubClient) FindAllWithResponse(_ context.Context, params *streams.FindAllParams, _ ...streams.RequestEditorFn) (*streams.FindAllResponse, error) {
+	s.offsets = append(s.offsets, params.Offset)
+	if s.status != http.StatusOK {
+		return &streams.FindAllResponse{HTTPResponse: testStubResponse(s.status), Body: []byte(`{}`)}, nil
+	}
+
+	start := min(int(params.Offset), len(s.streams))
+	end := min(start+int(params.Limit), len(s.streams))
+	body, err := json.Marshal(map[string]interface{}{"data": s.streams[start:end]})
+	if err != nil {
+		return nil, err
+	}
+	return &streams.FindAllResponse{HTTPResponse: testStubResponse(http.StatusOK), Body: body}, nil
+}
+
+func readInventoryDataSource(t *testing.T, qnd QuickNodeData) (InventoryDataSourceModel, []InventoryEndpointModel, []InventoryStreamModel, *datasource.ReadResponse) {
+	t.Helper()
+
+	d := NewInventoryDataSource().(*InventoryDataSource)
+	d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: qnd}, &datasource.ConfigureResponse{})
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	d.Read(context.Background(), datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}, resp)
+
+	var result InventoryDataSourceModel
+	var endpoints []InventoryEndpointModel
+	var streamModels []InventoryStreamModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &result)...)
+		resp.Diagnostics.Append(result.Endpoints.ElementsAs(context.Background(), &endpoints, false)...)
+		resp.Diagnostics.Append(result.Streams.ElementsAs(context.Background(), &streamModels, false)...)
+	}
+	return result, endpoints, streamModels, resp
+}
+
+func TestInventoryDataSource(t *testing.T) {
+	client := &endpointLookupStubClient{endpoints: []quicknode.Endpoint{testLookupEndpoint("endpoint-1", "payments"), testLookupEndpoint("endpoint-2", "indexer")}}
+
+	var listed []map[string]interface{}
+	for i := range streamListPageSize + 1 {
+		listed = append(listed, map[string]interface{}{
+			"id":          fmt.Sprintf("stream-%d", i),
+			"name":        fmt.Sprintf("blocks-%d", i),
+			"network":     "ethereum-mainnet",
+			"dataset":     "block",
+			"destination": "webhook",
+		})
+	}
+	streamsClient := &streamListStubClient{status: http.StatusOK, streams: listed}
+
+	_, endpoints, streamModels, resp := readInventoryDataSource(t, QuickNodeData{Client: client, StreamsClient: streamsClient})
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(endpoints) != 2 || endpoints[0].Id.ValueString() != "endpoint-1" || endpoints[1].Id.ValueString() != "endpoint-2" {
+		t.Errorf("expected endpoints endpoint-1 and endpoint-2, got %v", endpoints)
+	}
+	if endpoints[0].Chain.ValueString() != "eth" || endpoints[0].Network.ValueString() != "mainnet" {
+		t.Errorf("expected endpoint on eth mainnet, got %v", endpoints[0])
+	}
+	if len(streamModels) != streamListPageSize+1 {
+		t.Fatalf("expected %d streams across pages, got %d", streamListPageSize+1, len(streamModels))
+	}
+	if len(streamsClient.offsets) != 2 || streamsClient.offsets[1] != streamListPageSize {
+		t.Errorf("expected a second page at offset %d, got offsets %v", streamListPageSize, streamsClient.offsets)
+	}
+	last := streamModels[streamListPageSize]
+	if last.Id.ValueString() != fmt.Sprintf("stream-%d", streamListPageSize) || last.Dataset.ValueString() != "block" || last.Destination.ValueString() != "webhook" {
+		t.Errorf("expected the last listed stream, got %v", last)
+	}
+}
+
+func TestInventoryDataSource_StreamsUnavailable(t *testing.T) {
+	client := &endpointLookupStubClient{endpoints: []quicknode.Endpoint{testLookupEndpoint("endpoint-1", "payments")}}
+
+	result, endpoints, _, resp := readInventoryDataSource(t, QuickNodeData{Client: client, StreamsClient: &streamListStubClient{status: http.StatusForbidden}})
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("expected a warning when streams cannot be listed, got %v", resp.Diagnostics)
+	}
+	if len(endpoints) != 1 {
+		t.Errorf("expected endpoints to be listed, got %v", endpoints)
+	}
+	if !result.Streams.IsNull() {
+		t.Errorf("expected null streams, got %v", result.Streams)
+	}
+}
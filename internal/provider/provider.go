@@ -21,30 +21,47 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/circlefin/terraform-provider-quicknode/api/quicknode"
 	"github.com/circlefin/terraform-provider-quicknode/api/streams"
 	"github.com/circlefin/terraform-provider-quicknode/internal/client/transport"
 	"github.com/circlefin/terraform-provider-quicknode/internal/utils"
+	"github.com/circlefin/terraform-provider-quicknode/internal/validators"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/oapi-codegen/oapi-codegen/v2/pkg/securityprovider"
 )
 
 const (
 	quicknodeEndpointDefault          = "https://api.quicknode.com"
 	quicknodeRequestsPerSecondDefault = 5
+	maxResponseBytesDefault           = 10 << 20
+
+	streamReadRetriesDefault       = 0
+	streamReadRetryIntervalDefault = 2 * time.Second
+
+	streamDeleteTimeoutDefault      = 5 * time.Minute
+	streamDeletePollIntervalDefault = time.Second
+	streamDeletePollIntervalMax     = 30 * time.Second
 )
 
 // Ensure ScaffoldingProvider satisfies various provider interfaces.
 var _ provider.Provider = &QuickNodeProvider{}
 var _ provider.ProviderWithFunctions = &QuickNodeProvider{}
+var _ provider.ProviderWithEphemeralResources = &QuickNodeProvider{}
 
 // QuickNodeData is provided in the DataSourceData and ResourceData to be made accessible by data and resources.
 type QuickNodeData struct {
@@ -52,6 +69,36 @@ type QuickNodeData struct {
 	StreamsClient streams.ClientWithResponsesInterface
 	Chains        []quicknode.Chain
 	ApiKey        string
+
+	// StreamReadRetries and StreamReadRetryInterval bound how long a newly
+	// created stream is polled for before its read is treated as a failure.
+	StreamReadRetries       int
+	StreamReadRetryInterval time.Duration
+
+	// StreamDeleteTimeout bounds the request deleting a stream.
+	StreamDeleteTimeout time.Duration
+
+	// StreamDeleteWaitTimeout bounds how long a deleted stream is polled for
+	// until the Streams API reports it gone. Zero disables the polling.
+	StreamDeleteWaitTimeout time.Duration
+
+	// DefaultDatasetBatchSize is used for streams that omit dataset_batch_size.
+	// Zero means no default was configured.
+	DefaultDatasetBatchSize int64
+
+	// DefaultElasticBatchByDataset is the elastic_batch_enabled of streams
+	// that omit it, keyed by their dataset.
+	DefaultElasticBatchByDataset map[string]bool
+
+	// DefaultTags are added to the tags of every endpoint.
+	DefaultTags []string
+
+	// AllowUnvalidatedNetwork lets streams use networks missing from
+	// validators.NetworkValidator, leaving the Streams API to validate them.
+	AllowUnvalidatedNetwork bool
+
+	// VerboseErrors adds the redacted response body to request errors.
+	VerboseErrors bool
 }
 
 // QuickNodeProvider defines the provider implementation.
@@ -65,8 +112,31 @@ type QuickNodeProvider struct {
 // QuickNodeProviderModel describes the provider data model.
 type QuickNodeProviderModel struct {
 	Endpoint          types.String `tfsdk:"endpoint"`
+	StreamsEndpoint   types.String `tfsdk:"streams_endpoint"`
 	ApiKey            types.String `tfsdk:"apikey"`
+	ApiKeys           types.List   `tfsdk:"apikeys"`
 	RequestsPerSecond types.Int64  `tfsdk:"requests_per_second"`
+
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
+	MaxApiCalls           types.Int64 `tfsdk:"max_api_calls"`
+	RetryOnStatus         types.List  `tfsdk:"retry_on_status"`
+	MaxResponseBytes      types.Int64 `tfsdk:"max_response_bytes"`
+	VerboseErrors         types.Bool  `tfsdk:"verbose_errors"`
+
+	OAuthClientId     types.String `tfsdk:"oauth_client_id"`
+	OAuthClientSecret types.String `tfsdk:"oauth_client_secret"`
+	OAuthTokenUrl     types.String `tfsdk:"oauth_token_url"`
+
+	StreamReadRetries          types.Int64 `tfsdk:"stream_read_retries"`
+	StreamReadRetryIntervalSec types.Int64 `tfsdk:"stream_read_retry_interval_sec"`
+	StreamDeleteTimeoutSec     types.Int64 `tfsdk:"stream_delete_timeout_sec"`
+	StreamDeleteWaitTimeoutSec types.Int64 `tfsdk:"stream_delete_wait_timeout_sec"`
+
+	DefaultDatasetBatchSize      types.Int64 `tfsdk:"default_dataset_batch_size"`
+	DefaultElasticBatchByDataset types.Map   `tfsdk:"default_elastic_batch_by_dataset"`
+	DefaultTags                  types.Set   `tfsdk:"default_tags"`
+
+	AllowUnvalidatedNetwork types.Bool `tfsdk:"allow_unvalidated_network"`
 }
 
 func (p *QuickNodeProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -81,15 +151,120 @@ func (p *QuickNodeProvider) Schema(ctx context.Context, req provider.SchemaReque
 				MarkdownDescription: "QuickNode API Endpoint",
 				Optional:            true,
 			},
+			"streams_endpoint": schema.StringAttribute{
+				MarkdownDescription: "QuickNode Streams API Endpoint used by `quicknode_stream`. Defaults to `https://api.quicknode.com` independently of `endpoint`.",
+				Optional:            true,
+			},
 			"apikey": schema.StringAttribute{
 				MarkdownDescription: "QuickNode API Key",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"apikeys": schema.ListAttribute{
+				MarkdownDescription: "QuickNode API Keys to use in place of `apikey`. Requests start with the first key and move on to the next one while a key is rate limited.",
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
 			"requests_per_second": schema.Int64Attribute{
-				MarkdownDescription: "Maximum requests per second to limit requests to quicknode api",
+				MarkdownDescription: "Maximum requests per second to limit requests to quicknode api. Can also be set with the `QUICKNODE_REQUESTS_PER_SECOND` environment variable.",
+				Optional:            true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests to the QuickNode and Streams APIs in flight at once, shared by all resources. Unlimited if not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					validators.MaxConcurrentRequestsValidator,
+				},
+			},
+			"max_api_calls": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests to the QuickNode and Streams APIs, retries included, for the whole run of Terraform. Requests beyond it fail without being sent. Unlimited if not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					validators.MaxApiCallsValidator,
+				},
+			},
+			"retry_on_status": schema.ListAttribute{
+				MarkdownDescription: "Additional HTTP status codes to retry requests on, such as `408` or `425`. Connection errors, `429` and `5xx` responses other than `501` are always retried.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.List{
+					validators.RetryOnStatusValidator,
+				},
+			},
+			"max_response_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size in bytes of a response body from the QuickNode and Streams APIs. Larger responses fail the request. Defaults to 10485760 (10 MiB).",
+				Optional:            true,
+				Validators: []validator.Int64{
+					validators.MaxResponseBytesValidator,
+				},
+			},
+			"verbose_errors": schema.BoolAttribute{
+				MarkdownDescription: "Include the JSON response body, with credentials redacted, in errors for unexpected API responses. Bodies that are not JSON are omitted. Defaults to false.",
+				Optional:            true,
+			},
+			"oauth_client_id": schema.StringAttribute{
+				MarkdownDescription: "OAuth2 client ID used to fetch bearer tokens for the QuickNode API with the client credentials grant, in place of `apikey`. Requires `oauth_client_secret` and `oauth_token_url`. The Streams API only accepts `apikey`.",
 				Optional:            true,
 			},
+			"oauth_client_secret": schema.StringAttribute{
+				MarkdownDescription: "OAuth2 client secret for `oauth_client_id`",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"oauth_token_url": schema.StringAttribute{
+				MarkdownDescription: "OAuth2 token endpoint for `oauth_client_id`",
+				Optional:            true,
+			},
+			"stream_read_retries": schema.Int64Attribute{
+				MarkdownDescription: "Number of times to retry reading a newly created stream that is not yet visible in the Streams API. Defaults to 0.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					validators.StreamReadRetriesValidator,
+				},
+			},
+			"stream_read_retry_interval_sec": schema.Int64Attribute{
+				MarkdownDescription: "Seconds to wait between retries of `stream_read_retries`. Defaults to 2.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					validators.StreamReadRetryIntervalValidator,
+				},
+			},
+			"stream_delete_timeout_sec": schema.Int64Attribute{
+				MarkdownDescription: "Seconds to wait for the Streams API to accept a stream deletion before failing it. Defaults to 300.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					validators.StreamDeleteTimeoutValidator,
+				},
+			},
+			"stream_delete_wait_timeout_sec": schema.Int64Attribute{
+				MarkdownDescription: "Seconds to wait after deleting a stream for the Streams API to report it gone, polling with exponential backoff. Defaults to 0, which returns as soon as the delete is accepted.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					validators.StreamDeleteWaitTimeoutValidator,
+				},
+			},
+			"allow_unvalidated_network": schema.BoolAttribute{
+				MarkdownDescription: "Allow networks this provider version does not know, such as newly launched ones, in `quicknode_stream` and the `quicknode_datasets` and `quicknode_stream_template` data sources. The Streams API is left to validate them. Defaults to false.",
+				Optional:            true,
+			},
+			"default_dataset_batch_size": schema.Int64Attribute{
+				MarkdownDescription: "`dataset_batch_size` used by streams that do not set one",
+				Optional:            true,
+				Validators: []validator.Int64{
+					validators.DatasetBatchSizeValidator,
+				},
+			},
+			"default_elastic_batch_by_dataset": schema.MapAttribute{
+				MarkdownDescription: "`elastic_batch_enabled` used by streams that do not set one, keyed by the stream's `dataset`",
+				Optional:            true,
+				ElementType:         types.BoolType,
+			},
+			"default_tags": schema.SetAttribute{
+				MarkdownDescription: "Tags added to every `quicknode_endpoint` alongside its own `tags`. Streams do not support tags.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
 		},
 	}
 }
@@ -108,13 +283,38 @@ func (p *QuickNodeProvider) Configure(ctx context.Context, req provider.Configur
 		endpoint = data.Endpoint.ValueString()
 	}
 
-	apiKey := os.Getenv("QUICKNODE_APIKEY")
+	streamsEndpoint := quicknodeEndpointDefault
+	if !data.StreamsEndpoint.IsNull() {
+		streamsEndpoint = data.StreamsEndpoint.ValueString()
+	}
 
-	if !data.ApiKey.IsNull() {
-		apiKey = data.ApiKey.ValueString()
+	apiKeys, diags := configuredApiKeys(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	apiKey := ""
+	if len(apiKeys) > 0 {
+		apiKey = apiKeys[0]
+	}
+
+	oauthAttributes := []types.String{data.OAuthClientId, data.OAuthClientSecret, data.OAuthTokenUrl}
+	oauthConfigured := 0
+	for _, v := range oauthAttributes {
+		if !v.IsNull() && v.ValueString() != "" {
+			oauthConfigured++
+		}
 	}
 
-	if apiKey == "" {
+	if oauthConfigured > 0 && oauthConfigured < len(oauthAttributes) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("oauth_client_id"),
+			"Incomplete Quicknode OAuth Configuration",
+			"oauth_client_id, oauth_client_secret and oauth_token_url must all be set to authenticate with OAuth2.",
+		)
+	}
+
+	useOAuth := oauthConfigured == len(oauthAttributes)
+
+	if apiKey == "" && !useOAuth && !diags.HasError() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("apikey"),
 			"Missing Quicknode API Key",
@@ -124,44 +324,96 @@ func (p *QuickNodeProvider) Configure(ctx context.Context, req provider.Configur
 		)
 	}
 
-	requestsPerSecond := quicknodeRequestsPerSecondDefault
-	if !data.RequestsPerSecond.IsNull() {
-		requestsPerSecond = int(data.RequestsPerSecond.ValueInt64())
+	requestsPerSecond, diags := configuredRequestsPerSecond(data)
+	resp.Diagnostics.Append(diags...)
+
+	var concurrencyLimiter *transport.ConcurrencyLimiter
+	if !data.MaxConcurrentRequests.IsNull() {
+		concurrencyLimiter = transport.NewConcurrencyLimiter(int(data.MaxConcurrentRequests.ValueInt64()))
+	}
+
+	var budget *transport.RequestBudget
+	if !data.MaxApiCalls.IsNull() {
+		budget = transport.NewRequestBudget(data.MaxApiCalls.ValueInt64())
+	}
+
+	var retryOnStatus []int
+	resp.Diagnostics.Append(data.RetryOnStatus.ElementsAs(ctx, &retryOnStatus, false)...)
+
+	maxResponseBytes := int64(maxResponseBytesDefault)
+	if !data.MaxResponseBytes.IsNull() {
+		maxResponseBytes = data.MaxResponseBytes.ValueInt64()
 	}
 
+	streamReadRetries := streamReadRetriesDefault
+	if !data.StreamReadRetries.IsNull() {
+		streamReadRetries = int(data.StreamReadRetries.ValueInt64())
+	}
+
+	streamReadRetryInterval := streamReadRetryIntervalDefault
+	if !data.StreamReadRetryIntervalSec.IsNull() {
+		streamReadRetryInterval = time.Duration(data.StreamReadRetryIntervalSec.ValueInt64()) * time.Second
+	}
+
+	streamDeleteTimeout := streamDeleteTimeoutDefault
+	if !data.StreamDeleteTimeoutSec.IsNull() {
+		streamDeleteTimeout = time.Duration(data.StreamDeleteTimeoutSec.ValueInt64()) * time.Second
+	}
+
+	var defaultElasticBatchByDataset map[string]bool
+	resp.Diagnostics.Append(data.DefaultElasticBatchByDataset.ElementsAs(ctx, &defaultElasticBatchByDataset, false)...)
+
+	var defaultTags []string
+	resp.Diagnostics.Append(data.DefaultTags.ElementsAs(ctx, &defaultTags, false)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	bearerTokenProvider, _ := securityprovider.NewSecurityProviderBearerToken(apiKey)
+	var authorize quicknode.RequestEditorFn
+	if useOAuth {
+		authorize = transport.NewClientCredentials(
+			data.OAuthClientId.ValueString(),
+			data.OAuthClientSecret.ValueString(),
+			data.OAuthTokenUrl.ValueString(),
+			transport.NewRetryableThrottledClient(requestsPerSecond, concurrencyLimiter, retryOnStatus, nil, maxResponseBytes, budget),
+		).Intercept
+	} else {
+		bearerTokenProvider, _ := securityprovider.NewSecurityProviderBearerToken(apiKey)
+		authorize = bearerTokenProvider.Intercept
+	}
+
+	var quicknodeKeys *transport.KeyRotation
+	if !useOAuth && len(apiKeys) > 1 {
+		quicknodeKeys = transport.NewKeyRotation(apiKeys, func(req *http.Request, key string) {
+			req.Header.Set("Authorization", "Bearer "+key)
+		})
+	}
+
 	client, _ := quicknode.NewClientWithResponses(
 		endpoint,
-		quicknode.WithHTTPClient(transport.NewRetryableThrottledClient(requestsPerSecond)),
-		quicknode.WithRequestEditorFn(bearerTokenProvider.Intercept),
+		quicknode.WithHTTPClient(transport.NewRetryableThrottledClient(requestsPerSecond, concurrencyLimiter, retryOnStatus, quicknodeKeys, maxResponseBytes, budget)),
+		quicknode.WithRequestEditorFn(authorize),
 	)
 
-	// Create Streams API client with x-api-key authentication
-	streamsClient, _ := streams.NewClientWithResponses(
-		"https://api.quicknode.com",
-		streams.WithHTTPClient(transport.NewRetryableThrottledClient(requestsPerSecond)),
-		streams.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
-			req.Header.Set("x-api-key", apiKey)
-			return nil
-		}),
-	)
+	streamsClient, _ := newStreamsClient(streamsEndpoint, apiKeys, requestsPerSecond, concurrencyLimiter, retryOnStatus, maxResponseBytes, budget)
 
+	// Chains are only used to validate endpoints during plan, so an
+	// unreachable API leaves them unset rather than failing every operation.
+	// Other request errors, such as a rejected API key, still fail.
+	var chains []quicknode.Chain
 	chainsResponse, err := client.ChainsWithResponse(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("%s - configuring provider", utils.ClientErrorSummary),
-			utils.BuildClientErrorMessage(err),
-		)
-
-		return
-	}
-
-	if chainsResponse.StatusCode() != 200 {
-		m, err := utils.BuildRequestErrorMessage(chainsResponse.Status(), chainsResponse.Body)
+	switch {
+	case err != nil:
+		tflog.Warn(ctx, "Could not fetch chains, endpoint chain and network are not validated during plan", map[string]interface{}{
+			"error": utils.BuildClientErrorMessage(err),
+		})
+	case chainsResponse.StatusCode() >= 500:
+		tflog.Warn(ctx, "Could not fetch chains, endpoint chain and network are not validated during plan", map[string]interface{}{
+			"status": chainsResponse.Status(),
+		})
+	case chainsResponse.StatusCode() != 200:
+		m, err := utils.BuildRequestErrorMessage(chainsResponse.Status(), chainsResponse.Body, data.VerboseErrors.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddWarning(fmt.Sprintf("%s - configuring provider", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
 		}
@@ -172,19 +424,136 @@ func (p *QuickNodeProvider) Configure(ctx context.Context, req provider.Configur
 		)
 
 		return
+	default:
+		chains = chainsResponse.JSON200.Data
 	}
 
-	chains := chainsResponse.JSON200.Data
-
 	qnd := QuickNodeData{
 		Client:        client,
 		StreamsClient: streamsClient,
 		Chains:        chains,
 		ApiKey:        apiKey,
+
+		StreamReadRetries:       streamReadRetries,
+		StreamReadRetryInterval: streamReadRetryInterval,
+		StreamDeleteTimeout:     streamDeleteTimeout,
+		StreamDeleteWaitTimeout: time.Duration(data.StreamDeleteWaitTimeoutSec.ValueInt64()) * time.Second,
+
+		DefaultDatasetBatchSize:      data.DefaultDatasetBatchSize.ValueInt64(),
+		DefaultElasticBatchByDataset: defaultElasticBatchByDataset,
+		DefaultTags:                  defaultTags,
+
+		AllowUnvalidatedNetwork: data.AllowUnvalidatedNetwork.ValueBool(),
+		VerboseErrors:           data.VerboseErrors.ValueBool(),
 	}
 
 	resp.DataSourceData = qnd
 	resp.ResourceData = qnd
+	resp.EphemeralResourceData = qnd
+}
+
+// configuredApiKeys returns apikeys, or else apikey falling back to the
+// QUICKNODE_APIKEY environment variable. It returns no keys if none is set.
+func configuredApiKeys(ctx context.Context, data QuickNodeProviderModel) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if data.ApiKeys.IsNull() {
+		apiKey := os.Getenv("QUICKNODE_APIKEY")
+		if !data.ApiKey.IsNull() {
+			apiKey = data.ApiKey.ValueString()
+		}
+		if apiKey == "" {
+			return nil, diags
+		}
+		return []string{apiKey}, diags
+	}
+
+	if !data.ApiKey.IsNull() {
+		diags.AddAttributeError(
+			path.Root("apikeys"),
+			"Conflicting Quicknode API Keys",
+			"Only one of apikey and apikeys can be set.",
+		)
+		return nil, diags
+	}
+
+	var apiKeys []string
+	diags.Append(data.ApiKeys.ElementsAs(ctx, &apiKeys, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if slices.Contains(apiKeys, "") {
+		diags.AddAttributeError(
+			path.Root("apikeys"),
+			"Invalid Quicknode API Keys",
+			"apikeys must not contain empty values.",
+		)
+		return nil, diags
+	}
+
+	return apiKeys, diags
+}
+
+// configuredRequestsPerSecond returns requests_per_second, falling back to the
+// QUICKNODE_REQUESTS_PER_SECOND environment variable and then the default.
+func configuredRequestsPerSecond(data QuickNodeProviderModel) (int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !data.RequestsPerSecond.IsNull() {
+		return int(data.RequestsPerSecond.ValueInt64()), diags
+	}
+
+	env := os.Getenv("QUICKNODE_REQUESTS_PER_SECOND")
+	if env == "" {
+		return quicknodeRequestsPerSecondDefault, diags
+	}
+
+	requestsPerSecond, err := strconv.Atoi(env)
+	if err != nil || requestsPerSecond < 1 {
+		diags.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid QUICKNODE_REQUESTS_PER_SECOND",
+			fmt.Sprintf("QUICKNODE_REQUESTS_PER_SECOND must be a positive integer, got: %q", env),
+		)
+		return quicknodeRequestsPerSecondDefault, diags
+	}
+
+	return requestsPerSecond, diags
+}
+
+// logRequestMetrics logs the retries and rate limit waits of a resource
+// operation, showing whether requests_per_second is too low.
+func logRequestMetrics(ctx context.Context, operation string, metrics *transport.RequestMetrics) {
+	tflog.Debug(ctx, "Request metrics", map[string]interface{}{
+		"operation":        operation,
+		"retries":          metrics.Retries(),
+		"throttle_wait_ms": metrics.ThrottleWait().Milliseconds(),
+	})
+}
+
+// newStreamsClient creates a Streams API client for endpoint with x-api-key
+// authentication, rotating through apiKeys while one is rate limited.
+func newStreamsClient(endpoint string, apiKeys []string, requestsPerSecond int, limiter *transport.ConcurrencyLimiter, retryStatuses []int, maxResponseBytes int64, budget *transport.RequestBudget) (*streams.ClientWithResponses, error) {
+	setKey := func(req *http.Request, key string) {
+		req.Header.Set("x-api-key", key)
+	}
+
+	var keys *transport.KeyRotation
+	if len(apiKeys) > 1 {
+		keys = transport.NewKeyRotation(apiKeys, setKey)
+	}
+
+	return streams.NewClientWithResponses(
+		endpoint,
+		streams.WithHTTPClient(transport.NewRetryableThrottledClient(requestsPerSecond, limiter, retryStatuses, keys, maxResponseBytes, budget)),
+		streams.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			if len(apiKeys) > 0 {
+				setKey(req, apiKeys[0])
+			}
+			return nil
+		}),
+	)
 }
 
 func (p *QuickNodeProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -194,14 +563,31 @@ func (p *QuickNodeProvider) Resources(ctx context.Context) []func() resource.Res
 	}
 }
 
+func (p *QuickNodeProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewStreamEphemeralResource,
+	}
+}
+
 func (p *QuickNodeProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewDatasetsDataSource,
+		NewEndpointDataSource,
+		NewEndpointMetricsDataSource,
 		NewFilterDataSource,
+		NewInventoryDataSource,
+		NewHealthcheckDataSource,
+		NewStreamFilterDataSource,
+		NewStreamTemplateDataSource,
+		NewStreamsUsageDataSource,
 	}
 }
 
 func (p *QuickNodeProvider) Functions(ctx context.Context) []func() function.Function {
-	return nil
+	return []func() function.Function{
+		NewEndpointUrlFunction,
+		NewValidateFilterFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -17,11 +17,21 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"slices"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 const (
@@ -50,3 +60,269 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("QUICKNODE_APIKEY must be set for acceptance tests")
 	}
 }
+
+func testProviderConfig(t *testing.T, p *QuickNodeProvider, data QuickNodeProviderModel) tfsdk.Config {
+	t.Helper()
+
+	var resp provider.SchemaResponse
+	p.Schema(context.Background(), provider.SchemaRequest{}, &resp)
+
+	if data.DefaultTags.ElementType(context.Background()) == nil {
+		data.DefaultTags = types.SetNull(types.StringType)
+	}
+	if data.RetryOnStatus.ElementType(context.Background()) == nil {
+		data.RetryOnStatus = types.ListNull(types.Int64Type)
+	}
+	if data.ApiKeys.ElementType(context.Background()) == nil {
+		data.ApiKeys = types.ListNull(types.StringType)
+	}
+	if data.DefaultElasticBatchByDataset.ElementType(context.Background()) == nil {
+		data.DefaultElasticBatchByDataset = types.MapNull(types.BoolType)
+	}
+
+	// tfsdk.Config has no setter, so build the raw value through a State.
+	state := tfsdk.State{Schema: resp.Schema, Raw: tftypes.NewValue(resp.Schema.Type().TerraformType(context.Background()), nil)}
+	if diags := state.Set(context.Background(), &data); diags.HasError() {
+		t.Fatalf("building config: %v", diags)
+	}
+	return tfsdk.Config{Schema: resp.Schema, Raw: state.Raw}
+}
+
+func TestProviderConfigure_StreamsEndpoint(t *testing.T) {
+	var apiPaths, streamsPaths []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiPaths = append(apiPaths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[],"error":null}`))
+	}))
+	defer api.Close()
+
+	streamsAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		streamsPaths = append(streamsPaths, r.URL.Path)
+		if got := r.Header.Get("x-api-key"); got != "test-key" {
+			t.Errorf("expected x-api-key 'test-key', got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(testStreamAPIResponse())
+	}))
+	defer streamsAPI.Close()
+
+	p := &QuickNodeProvider{}
+	resp := &provider.ConfigureResponse{}
+	p.Configure(context.Background(), provider.ConfigureRequest{
+		Config: testProviderConfig(t, p, QuickNodeProviderModel{
+			Endpoint:        types.StringValue(api.URL),
+			StreamsEndpoint: types.StringValue(streamsAPI.URL),
+			ApiKey:          types.StringValue("test-key"),
+		}),
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("configuring provider: %v", resp.Diagnostics)
+	}
+
+	qnd := resp.ResourceData.(QuickNodeData)
+	r := &StreamResource{client: qnd.StreamsClient}
+	if _, err := r.readStreamFromAPI(context.Background(), "stream-123"); err != nil {
+		t.Fatalf("reading stream: %v", err)
+	}
+
+	if len(apiPaths) != 1 || apiPaths[0] != "/v0/chains" {
+		t.Errorf("expected only the chains request on the QuickNode API, got %v", apiPaths)
+	}
+	if len(streamsPaths) != 1 || streamsPaths[0] != "/streams/rest/v1/streams/stream-123" {
+		t.Errorf("expected the stream read on the streams endpoint, got %v", streamsPaths)
+	}
+}
+
+func TestProviderConfigure_ChainsUnavailable(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer api.Close()
+
+	p := &QuickNodeProvider{}
+	resp := &provider.ConfigureResponse{}
+	p.Configure(context.Background(), provider.ConfigureRequest{
+		Config: testProviderConfig(t, p, QuickNodeProviderModel{
+			Endpoint: types.StringValue(api.URL),
+			ApiKey:   types.StringValue("test-key"),
+		}),
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected the provider to configure without chains, got: %v", resp.Diagnostics)
+	}
+
+	qnd := resp.ResourceData.(QuickNodeData)
+	if qnd.Chains != nil {
+		t.Fatalf("expected no chains, got %v", qnd.Chains)
+	}
+
+	r := &EndpointResource{chains: qnd.Chains}
+	state := testEndpointState(t, testEndpointModel(false))
+	planResp := &fwresource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}}
+	r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+		Plan:   tfsdk.Plan{Schema: state.Schema, Raw: state.Raw},
+	}, planResp)
+
+	if planResp.Diagnostics.HasError() {
+		t.Fatalf("expected the plan to proceed without chains, got: %v", planResp.Diagnostics)
+	}
+	if planResp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("expected a warning that chain and network are not validated, got: %v", planResp.Diagnostics)
+	}
+}
+
+func TestProviderConfigure_OAuthClientCredentials(t *testing.T) {
+	token := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"oauth-token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer token.Close()
+
+	var gotAuthorization string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuthorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[],"error":null}`))
+	}))
+	defer api.Close()
+
+	t.Setenv("QUICKNODE_APIKEY", "")
+
+	p := &QuickNodeProvider{}
+	resp := &provider.ConfigureResponse{}
+	p.Configure(context.Background(), provider.ConfigureRequest{
+		Config: testProviderConfig(t, p, QuickNodeProviderModel{
+			Endpoint:          types.StringValue(api.URL),
+			OAuthClientId:     types.StringValue("client-id"),
+			OAuthClientSecret: types.StringValue("client-secret"),
+			OAuthTokenUrl:     types.StringValue(token.URL),
+		}),
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("configuring provider: %v", resp.Diagnostics)
+	}
+	if gotAuthorization != "Bearer oauth-token" {
+		t.Errorf("expected the OAuth token on the chains request, got %q", gotAuthorization)
+	}
+}
+
+func TestProviderConfigure_IncompleteOAuth(t *testing.T) {
+	p := &QuickNodeProvider{}
+	resp := &provider.ConfigureResponse{}
+	p.Configure(context.Background(), provider.ConfigureRequest{
+		Config: testProviderConfig(t, p, QuickNodeProviderModel{
+			ApiKey:        types.StringValue("test-key"),
+			OAuthClientId: types.StringValue("client-id"),
+		}),
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error diagnostics for incomplete OAuth configuration")
+	}
+	if got := resp.Diagnostics.Errors()[0].Summary(); got != "Incomplete Quicknode OAuth Configuration" {
+		t.Errorf("unexpected summary %q", got)
+	}
+}
+
+func TestConfiguredRequestsPerSecond(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		attribute   types.Int64
+		env         string
+		expected    int
+		expectError bool
+	}{
+		{name: "default", attribute: types.Int64Null(), expected: quicknodeRequestsPerSecondDefault},
+		{name: "environment", attribute: types.Int64Null(), env: "20", expected: 20},
+		{name: "attribute takes precedence", attribute: types.Int64Value(2), env: "20", expected: 2},
+		{name: "invalid environment", attribute: types.Int64Null(), env: "fast", expectError: true},
+		{name: "non-positive environment", attribute: types.Int64Null(), env: "0", expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("QUICKNODE_REQUESTS_PER_SECOND", tc.env)
+
+			got, diags := configuredRequestsPerSecond(QuickNodeProviderModel{RequestsPerSecond: tc.attribute})
+
+			if diags.HasError() != tc.expectError {
+				t.Fatalf("expected error %t, got diagnostics: %v", tc.expectError, diags)
+			}
+			if !tc.expectError && got != tc.expected {
+				t.Errorf("expected %d requests per second, got %d", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestConfiguredApiKeys(t *testing.T) {
+	keys := func(values ...string) types.List {
+		list, _ := types.ListValueFrom(context.Background(), types.StringType, values)
+		return list
+	}
+
+	for _, tc := range []struct {
+		name        string
+		apiKey      types.String
+		apiKeys     types.List
+		env         string
+		expected    []string
+		expectError bool
+	}{
+		{name: "environment", apiKey: types.StringNull(), apiKeys: types.ListNull(types.StringType), env: "env-key", expected: []string{"env-key"}},
+		{name: "apikey", apiKey: types.StringValue("key"), apiKeys: types.ListNull(types.StringType), env: "env-key", expected: []string{"key"}},
+		{name: "apikeys", apiKey: types.StringNull(), apiKeys: keys("first", "second"), env: "env-key", expected: []string{"first", "second"}},
+		{name: "none", apiKey: types.StringNull(), apiKeys: types.ListNull(types.StringType)},
+		{name: "both", apiKey: types.StringValue("key"), apiKeys: keys("first"), expectError: true},
+		{name: "empty key", apiKey: types.StringNull(), apiKeys: keys("first", ""), expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("QUICKNODE_APIKEY", tc.env)
+
+			got, diags := configuredApiKeys(context.Background(), QuickNodeProviderModel{ApiKey: tc.apiKey, ApiKeys: tc.apiKeys})
+
+			if diags.HasError() != tc.expectError {
+				t.Fatalf("expected error %t, got diagnostics: %v", tc.expectError, diags)
+			}
+			if !slices.Equal(got, tc.expected) {
+				t.Errorf("expected keys %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestProviderConfigure_VerboseErrorsPerProvider(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[],"error":null}`))
+	}))
+	defer api.Close()
+
+	configure := func(verbose types.Bool) QuickNodeData {
+		p := &QuickNodeProvider{}
+		resp := &provider.ConfigureResponse{}
+		p.Configure(context.Background(), provider.ConfigureRequest{
+			Config: testProviderConfig(t, p, QuickNodeProviderModel{
+				Endpoint:      types.StringValue(api.URL),
+				ApiKey:        types.StringValue("test-key"),
+				VerboseErrors: verbose,
+			}),
+		}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("configuring provider: %v", resp.Diagnostics)
+		}
+		return resp.ResourceData.(QuickNodeData)
+	}
+
+	verbose := configure(types.BoolValue(true))
+	quiet := configure(types.BoolNull())
+
+	if !verbose.VerboseErrors {
+		t.Errorf("expected verbose errors for the provider that enables them")
+	}
+	if quiet.VerboseErrors {
+		t.Errorf("expected a second provider configuration not to inherit verbose errors")
+	}
+}
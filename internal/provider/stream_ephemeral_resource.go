@@ -0,0 +1,127 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/circlefin/terraform-provider-quicknode/api/streams"
+	"github.com/circlefin/terraform-provider-quicknode/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StreamEphemeralResource reads an existing stream's webhook security_token
+// without writing it to plan or state, for wiring the secret into a receiver
+// from Terraform 1.10 onwards.
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &StreamEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &StreamEphemeralResource{}
+)
+
+// StreamEphemeralResourceModel describes the data structure.
+type StreamEphemeralResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	SecurityToken types.String `tfsdk:"security_token"`
+}
+
+// StreamEphemeralResource implements ephemeral.EphemeralResource.
+type StreamEphemeralResource struct {
+	client streams.ClientWithResponsesInterface
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *StreamEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stream"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *StreamEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the webhook `security_token` of an existing stream without storing it in the plan or state. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the stream",
+			},
+			"security_token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Secret QuickNode uses to sign the stream's webhook payloads. Null for destinations other than webhook.",
+			},
+		},
+	}
+}
+
+func (e *StreamEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	qnd, ok := req.ProviderData.(QuickNodeData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData type",
+			fmt.Sprintf("Expected QuickNodeData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = qnd.StreamsClient
+}
+
+// Open reads the stream and returns its security_token.
+func (e *StreamEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data StreamEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stream, err := (&StreamResource{client: e.client}).readStreamFromAPI(ctx, data.Id.ValueString())
+	if errors.Is(err, errStreamNotFound) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Stream Not Found",
+			fmt.Sprintf("No stream with id %s exists", data.Id.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("%s - Reading Stream", utils.ClientErrorSummary),
+			utils.BuildClientErrorMessage(err),
+		)
+		return
+	}
+
+	data.SecurityToken = destinationAttributeString(*stream, "security_token")
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// NewStreamEphemeralResource returns a new instance of the ephemeral resource.
+func NewStreamEphemeralResource() ephemeral.EphemeralResource {
+	return &StreamEphemeralResource{}
+}
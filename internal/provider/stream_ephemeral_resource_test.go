@@ -0,0 +1,91 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// openStreamEphemeralResource runs the ephemeral resource lifecycle for id
+// against client and returns the opened result.
+func openStreamEphemeralResource(t *testing.T, client *streamStubClient, id string) (StreamEphemeralResourceModel, *ephemeral.OpenResponse) {
+	t.Helper()
+
+	e := NewStreamEphemeralResource().(*StreamEphemeralResource)
+	e.Configure(context.Background(), ephemeral.ConfigureRequest{ProviderData: QuickNodeData{StreamsClient: client}}, &ephemeral.ConfigureResponse{})
+
+	var schemaResp ephemeral.SchemaResponse
+	e.Schema(context.Background(), ephemeral.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":             tftypes.NewValue(tftypes.String, id),
+			"security_token": tftypes.NewValue(tftypes.String, nil),
+		}),
+	}
+
+	resp := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	e.Open(context.Background(), ephemeral.OpenRequest{Config: config}, resp)
+
+	var result StreamEphemeralResourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.Result.Get(context.Background(), &result)...)
+	}
+	return result, resp
+}
+
+func TestStreamEphemeralResource_Open(t *testing.T) {
+	stream := testStreamAPIResponse()
+	stream["destination"] = "webhook"
+	stream["destination_attributes"] = map[string]interface{}{
+		"url":            "https://example.com/hook",
+		"security_token": "generated-by-quicknode-0123456789abcdef",
+	}
+
+	result, resp := openStreamEphemeralResource(t, &streamStubClient{stream: stream}, "stream-123")
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !result.SecurityToken.Equal(types.StringValue("generated-by-quicknode-0123456789abcdef")) {
+		t.Errorf("expected the stream's security token, got %v", result.SecurityToken)
+	}
+}
+
+func TestStreamEphemeralResource_NotFound(t *testing.T) {
+	client := &streamStubClient{findOneStatuses: []int{http.StatusNotFound}}
+
+	_, resp := openStreamEphemeralResource(t, client, "missing")
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error diagnostics for a missing stream")
+	}
+	if got := resp.Diagnostics.Errors()[0].Summary(); got != "Stream Not Found" {
+		t.Errorf("expected summary 'Stream Not Found', got %q", got)
+	}
+}
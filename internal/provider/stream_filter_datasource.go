@@ -0,0 +1,163 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/circlefin/terraform-provider-quicknode/api/streams"
+	"github.com/circlefin/terraform-provider-quicknode/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &StreamFilterDataSource{}
+	_ datasource.DataSourceWithConfigure = &StreamFilterDataSource{}
+)
+
+// StreamFilterDataSourceModel describes the data structure.
+type StreamFilterDataSourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	FilterCode    types.String `tfsdk:"filter_code"`
+	Base64Encoded types.String `tfsdk:"base64_encoded"`
+}
+
+// StreamFilterDataSource implements datasource.DataSource.
+type StreamFilterDataSource struct {
+	client streams.ClientWithResponsesInterface
+
+	verboseErrors bool
+}
+
+// Metadata returns the data source type name.
+func (d *StreamFilterDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stream_filter"
+}
+
+// Schema defines the schema for the data source.
+func (d *StreamFilterDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the filter stored on an existing stream and decodes it. Both attributes are null for a stream without a filter.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the stream",
+			},
+			"filter_code": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Decoded JavaScript filter code",
+			},
+			"base64_encoded": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Filter code as stored on the stream, base64 encoded",
+			},
+		},
+	}
+}
+
+func (d *StreamFilterDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	qnd, ok := req.ProviderData.(QuickNodeData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData type",
+			fmt.Sprintf("Expected QuickNodeData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = qnd.StreamsClient
+	d.verboseErrors = qnd.VerboseErrors
+}
+
+// Read reads the data source.
+func (d *StreamFilterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StreamFilterDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResp, err := d.client.FindOneWithResponse(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("%s - Reading Stream", utils.ClientErrorSummary),
+			utils.BuildClientErrorMessage(err),
+		)
+		return
+	}
+
+	switch readResp.StatusCode() {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Stream Not Found",
+			fmt.Sprintf("No stream has id %q", data.Id.ValueString()),
+		)
+		return
+	default:
+		m, err := utils.BuildRequestErrorMessage(readResp.Status(), readResp.Body, d.verboseErrors)
+		if err != nil {
+			resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Reading Stream", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("%s - Reading Stream", utils.RequestErrorSummary),
+			m,
+		)
+		return
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(unwrapDataEnvelope(readResp.Body), &result); err != nil {
+		resp.Diagnostics.AddError("Error parsing response", fmt.Sprintf("Could not parse stream from API: %v", err))
+		return
+	}
+
+	data.FilterCode = types.StringNull()
+	data.Base64Encoded = types.StringNull()
+
+	if encoded, _ := result["filter_function"].(string); encoded != "" {
+		if err := checkFilterFunction(encoded); err != nil {
+			resp.Diagnostics.AddError("Error decoding filter", fmt.Sprintf("The filter stored on stream %s cannot be decoded: %v", data.Id.ValueString(), err))
+			return
+		}
+
+		decoded, _ := base64.StdEncoding.DecodeString(encoded)
+		data.FilterCode = types.StringValue(string(decoded))
+		data.Base64Encoded = types.StringValue(encoded)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// NewStreamFilterDataSource returns a new instance of the data source.
+func NewStreamFilterDataSource() datasource.DataSource {
+	return &StreamFilterDataSource{}
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func readStreamFilterDataSource(t *testing.T, client *streamStubClient) (StreamFilterDataSourceModel, *datasource.ReadResponse) {
+	t.Helper()
+
+	d := NewStreamFilterDataSource().(*StreamFilterDataSource)
+	d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: QuickNodeData{StreamsClient: client}}, &datasource.ConfigureResponse{})
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+	config.Set(context.Background(), &StreamFilterDataSourceModel{
+		Id:            types.StringValue("stream-123"),
+		FilterCode:    types.StringNull(),
+		Base64Encoded: types.StringNull(),
+	})
+
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	d.Read(context.Background(), datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw},
+	}, resp)
+
+	var result StreamFilterDataSourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &result)...)
+	}
+	return result, resp
+}
+
+func TestStreamFilterDataSource(t *testing.T) {
+	// base64 of "function main(stream) { return stream; }"
+	encoded := "ZnVuY3Rpb24gbWFpbihzdHJlYW0pIHsgcmV0dXJuIHN0cmVhbTsgfQ=="
+	client := &streamStubClient{stream: map[string]interface{}{
+		"data": map[string]interface{}{"id": "stream-123", "filter_function": encoded},
+	}}
+
+	result, resp := readStreamFilterDataSource(t, client)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !result.FilterCode.Equal(types.StringValue("function main(stream) { return stream; }")) {
+		t.Errorf("expected decoded filter, got %v", result.FilterCode)
+	}
+	if !result.Base64Encoded.Equal(types.StringValue(encoded)) {
+		t.Errorf("expected stored filter %q, got %v", encoded, result.Base64Encoded)
+	}
+}
+
+func TestStreamFilterDataSource_NoFilter(t *testing.T) {
+	result, resp := readStreamFilterDataSource(t, &streamStubClient{stream: map[string]interface{}{"id": "stream-123", "filter_function": ""}})
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !result.FilterCode.IsNull() || !result.Base64Encoded.IsNull() {
+		t.Errorf("expected null filter attributes, got %v and %v", result.FilterCode, result.Base64Encoded)
+	}
+}
+
+func TestStreamFilterDataSource_NotFound(t *testing.T) {
+	_, resp := readStreamFilterDataSource(t, &streamStubClient{findOneStatuses: []int{http.StatusNotFound}})
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error diagnostics for a missing stream")
+	}
+}
@@ -18,38 +18,50 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"net/http"
+	"slices"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/circlefin/terraform-provider-quicknode/api/streams"
+	"github.com/circlefin/terraform-provider-quicknode/internal/client/transport"
 	"github.com/circlefin/terraform-provider-quicknode/internal/utils"
 	"github.com/circlefin/terraform-provider-quicknode/internal/validators"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &StreamResource{}
-	_ resource.ResourceWithImportState = &StreamResource{}
+	_ resource.Resource                   = &StreamResource{}
+	_ resource.ResourceWithImportState    = &StreamResource{}
+	_ resource.ResourceWithValidateConfig = &StreamResource{}
+	_ resource.ResourceWithModifyPlan     = &StreamResource{}
 )
 
 var (
+	streamNameValidator          = validators.StreamNameValidator
 	networkValidator             = validators.NetworkValidator
 	datasetValidator             = validators.DatasetValidator
 	metadataValidator            = validators.MetadataValidator
 	destinationValidator         = validators.DestinationValidator
 	statusValidator              = validators.StatusValidator
 	regionValidator              = validators.RegionValidator
-	compressionValidator         = validators.CompressionValidator
-	fileCompressionValidator     = validators.FileCompressionValidator
 	fileTypeValidator            = validators.FileTypeValidator
 	sslmodeValidator             = validators.SslmodeValidator
 	securityTokenValidator       = validators.SecurityTokenValidator
@@ -63,6 +75,9 @@ var (
 	retryIntervalSecValidator    = validators.RetryIntervalSecValidator
 	postTimeoutSecValidator      = validators.PostTimeoutSecValidator
 	portValidator                = validators.PortValidator
+	webhookHeadersValidator      = validators.WebhookHeadersValidator
+	webhookHeadersSizeValidator  = validators.WebhookHeadersSizeValidator
+	s3EndpointValidator          = validators.S3EndpointValidator{}
 )
 
 // StreamResourceModel represents the Terraform state structure.
@@ -84,6 +99,9 @@ type StreamResourceModel struct {
 	NotificationEmail     types.String `tfsdk:"notification_email"`
 	DestinationAttributes types.Object `tfsdk:"destination_attributes"`
 	FilterFunction        types.String `tfsdk:"filter_function"`
+
+	DestinationAttributesJson types.String `tfsdk:"destination_attributes_json"`
+	ReplaceTriggers           types.Map    `tfsdk:"replace_triggers"`
 }
 
 // OptionalFields represents optional fields that can be null or have values.
@@ -95,6 +113,20 @@ type OptionalFields struct {
 	FilterFunction      *string
 }
 
+// checkFilterFunction decodes a base64 filter_function and checks the result is
+// UTF-8 text, so a malformed filter is reported against the attribute rather
+// than as a generic API error.
+func checkFilterFunction(encoded string) error {
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("filter_function must be base64 encoded: %w", err)
+	}
+	if !utf8.Valid(decoded) {
+		return fmt.Errorf("filter_function must decode to UTF-8 JavaScript source")
+	}
+	return nil
+}
+
 // prepareOptionalFields extracts optional fields from StreamResourceModel and converts them to appropriate types.
 func prepareOptionalFields(data StreamResourceModel) OptionalFields {
 	fields := OptionalFields{}
@@ -133,8 +165,27 @@ func NewStreamResource() resource.Resource {
 
 type StreamResource struct {
 	client streams.ClientWithResponsesInterface
+
+	readRetries       int
+	readRetryInterval time.Duration
+
+	// deleteTimeout bounds the delete request. Zero leaves it unbounded.
+	deleteTimeout      time.Duration
+	deleteWaitTimeout  time.Duration
+	deletePollInterval time.Duration
+
+	defaultDatasetBatchSize      int64
+	defaultElasticBatchByDataset map[string]bool
+
+	allowUnvalidatedNetwork bool
+	verboseErrors           bool
 }
 
+var (
+	errStreamNotFound = errors.New("stream not found")
+	errStreamPartial  = errors.New("stream response is missing its id")
+)
+
 func (r *StreamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -150,6 +201,15 @@ func (r *StreamResource) Configure(ctx context.Context, req resource.ConfigureRe
 	}
 
 	r.client = qnd.StreamsClient
+	r.readRetries = qnd.StreamReadRetries
+	r.readRetryInterval = qnd.StreamReadRetryInterval
+	r.deleteTimeout = qnd.StreamDeleteTimeout
+	r.deleteWaitTimeout = qnd.StreamDeleteWaitTimeout
+	r.deletePollInterval = streamDeletePollIntervalDefault
+	r.defaultDatasetBatchSize = qnd.DefaultDatasetBatchSize
+	r.defaultElasticBatchByDataset = qnd.DefaultElasticBatchByDataset
+	r.allowUnvalidatedNetwork = qnd.AllowUnvalidatedNetwork
+	r.verboseErrors = qnd.VerboseErrors
 }
 
 func (r *StreamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -166,15 +226,35 @@ func (r *StreamResource) Schema(ctx context.Context, req resource.SchemaRequest,
 
 			"name": schema.StringAttribute{
 				Required: true,
+				Validators: []validator.String{
+					streamNameValidator,
+				},
 			},
 
 			"network": schema.StringAttribute{
-				Required: true,
+				MarkdownDescription: "Network slug of the stream. Matched ignoring case and sent to the Streams API in lowercase.",
+				Required:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfNetworkChanged,
+						"Changing the network, other than its case, requires replacing the stream.",
+						"Changing the network, other than its case, requires replacing the stream.",
+					),
 				},
-				Validators: []validator.String{
-					networkValidator,
+				// Validated in ModifyPlan, which knows the provider's
+				// allow_unvalidated_network.
+			},
+
+			"replace_triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that replace the stream when any of them changes, such as the version of an external filter. Not sent to the Streams API.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfTriggersChanged,
+						"Changing replace_triggers requires replacing the stream.",
+						"Changing `replace_triggers` requires replacing the stream.",
+					),
 				},
 			},
 
@@ -203,7 +283,9 @@ func (r *StreamResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 
 			"dataset_batch_size": schema.Int64Attribute{
-				Required: true,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Number of blocks per batch. Falls back to the provider's `default_dataset_batch_size` when unset.",
 				Validators: []validator.Int64{
 					datasetBatchSizeValidator,
 				},
@@ -232,7 +314,9 @@ func (r *StreamResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 
 			"elastic_batch_enabled": schema.BoolAttribute{
-				Required: true,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether elastic batching is enabled. Falls back to the provider's `default_elastic_batch_by_dataset` for the stream's `dataset` when unset.",
 			},
 
 			"region": schema.StringAttribute{
@@ -250,7 +334,8 @@ func (r *StreamResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 
 			"keep_distance_from_tip": schema.Int64Attribute{
-				Optional: true,
+				MarkdownDescription: "Number of blocks to stay behind the chain tip, counted in slots on Solana networks. At most 10000, except on Solana.",
+				Optional:            true,
 				Validators: []validator.Int64{
 					keepDistanceFromTipValidator,
 				},
@@ -268,23 +353,31 @@ func (r *StreamResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "JavaScript function to filter and modify stream data. Must be base64 encoded.",
 			},
 
+			"destination_attributes_json": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Destination attributes as a JSON object, sent to the Streams API as is. An escape hatch for destinations or fields the provider does not support yet, such as `kafka`. Conflicts with `destination_attributes`.",
+			},
 			"destination_attributes": schema.SingleNestedAttribute{
-				Required: true,
+				Optional:            true,
+				MarkdownDescription: "Destination attributes. Exactly one of `destination_attributes` and `destination_attributes_json` must be set. Only the fields of the configured `destination` are used; setting another destination's fields produces a warning.",
 				Attributes: map[string]schema.Attribute{
 					"url": schema.StringAttribute{
 						Optional: true,
 					},
 
 					"compression": schema.StringAttribute{
-						Optional: true,
-						Validators: []validator.String{
-							compressionValidator,
-						},
+						Optional:            true,
+						MarkdownDescription: "Compression of webhook payloads, `none` or `gzip`. The provider does not add a `Content-Encoding` header for `gzip`; set `Content-Encoding = \"gzip\"` in `headers` if the receiver relies on it. A `Content-Encoding` header that conflicts with `compression` is rejected.",
 					},
 
 					"headers": schema.MapAttribute{
 						Optional:    true,
 						ElementType: types.StringType,
+						Validators: []validator.Map{
+							webhookHeadersValidator,
+							webhookHeadersSizeValidator,
+						},
 					},
 
 					"max_retry": schema.Int64Attribute{
@@ -310,27 +403,40 @@ func (r *StreamResource) Schema(ctx context.Context, req resource.SchemaRequest,
 
 					"security_token": schema.StringAttribute{
 						// If unset, the server will generate one for you
-						Optional:  true,
-						Sensitive: true,
-						Computed:  true,
+						Optional:            true,
+						Sensitive:           true,
+						Computed:            true,
+						MarkdownDescription: "Secret QuickNode uses to sign webhook payloads so receivers can verify them with HMAC. Generated by QuickNode when omitted.",
 						Validators: []validator.String{
 							securityTokenValidator,
 						},
+						// Keep the generated token across plans rather than
+						// showing it as known after apply on every change.
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
 					},
 
 					"version": schema.StringAttribute{
 						Optional: true,
 						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
 					},
 
 					"access_key": schema.StringAttribute{
-						Optional:  true,
-						Sensitive: true,
+						Optional:      true,
+						Computed:      true,
+						Sensitive:     true,
+						PlanModifiers: []planmodifier.String{preserveOmittedSecret{}},
 					},
 
 					"secret_key": schema.StringAttribute{
-						Optional:  true,
-						Sensitive: true,
+						Optional:      true,
+						Computed:      true,
+						Sensitive:     true,
+						PlanModifiers: []planmodifier.String{preserveOmittedSecret{}},
 					},
 
 					"bucket": schema.StringAttribute{
@@ -342,11 +448,16 @@ func (r *StreamResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					},
 
 					"endpoint": schema.StringAttribute{
-						Optional: true,
+						Optional:            true,
+						MarkdownDescription: "S3 endpoint host such as `s3.amazonaws.com`, or an http(s) URL for S3-compatible stores. A URL's scheme must agree with `use_ssl`.",
+						Validators: []validator.String{
+							s3EndpointValidator,
+						},
 					},
 
 					"object_prefix": schema.StringAttribute{
-						Optional: true,
+						MarkdownDescription: "Prefix prepended to object names. End it with `/` to write objects into a folder.",
+						Optional:            true,
 					},
 
 					"use_ssl": schema.BoolAttribute{
@@ -358,8 +469,10 @@ func (r *StreamResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					},
 
 					"password": schema.StringAttribute{
-						Optional:  true,
-						Sensitive: true,
+						Optional:      true,
+						Computed:      true,
+						Sensitive:     true,
+						PlanModifiers: []planmodifier.String{preserveOmittedSecret{}},
 					},
 
 					"host": schema.StringAttribute{
@@ -383,9 +496,6 @@ func (r *StreamResource) Schema(ctx context.Context, req resource.SchemaRequest,
 
 					"file_compression": schema.StringAttribute{
 						Optional: true,
-						Validators: []validator.String{
-							fileCompressionValidator,
-						},
 					},
 
 					"file_type": schema.StringAttribute{
@@ -401,12 +511,178 @@ func (r *StreamResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							sslmodeValidator,
 						},
 					},
+
+					"access_key_wo": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+						MarkdownDescription: "Write-only alternative to `access_key` that is sent to QuickNode but never stored in state. Requires Terraform 1.11 or later. Change `credentials_wo_version` to send a new value.",
+					},
+
+					"secret_key_wo": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+						MarkdownDescription: "Write-only alternative to `secret_key` that is sent to QuickNode but never stored in state. Requires Terraform 1.11 or later. Change `credentials_wo_version` to send a new value.",
+					},
+
+					"password_wo": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+						MarkdownDescription: "Write-only alternative to `password` that is sent to QuickNode but never stored in state. Requires Terraform 1.11 or later. Change `credentials_wo_version` to send a new value.",
+					},
+
+					"credentials_wo_version": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Version of the write-only credentials. Write-only values never show a diff, so change this to update the stream with them.",
+					},
 				},
 			},
 		},
 	}
 }
 
+func (r *StreamResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction and we need no defaults.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config StreamResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkNetwork(ctx, config.Network, r.allowUnvalidatedNetwork)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkRangePrecision(config)...)
+
+	var plan StreamResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(checkPostgresAccessKey(config, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.DatasetBatchSize.IsNull() {
+		if r.defaultDatasetBatchSize == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("dataset_batch_size"),
+				"Missing dataset_batch_size",
+				"dataset_batch_size must be set on the stream or defaulted with the provider's default_dataset_batch_size",
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dataset_batch_size"), types.Int64Value(r.defaultDatasetBatchSize))...)
+	}
+
+	// The default depends on the dataset, which is only known at apply in
+	// some plans. Terraform plans again once it is known.
+	if config.ElasticBatchEnabled.IsNull() && !config.Dataset.IsUnknown() {
+		elasticBatchEnabled, ok := r.defaultElasticBatchByDataset[config.Dataset.ValueString()]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("elastic_batch_enabled"),
+				"Missing elastic_batch_enabled",
+				fmt.Sprintf("elastic_batch_enabled must be set on the stream or defaulted for the %s dataset with the provider's default_elastic_batch_by_dataset", config.Dataset.ValueString()),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("elastic_batch_enabled"), types.BoolValue(elasticBatchEnabled))...)
+	}
+}
+
+// checkNetwork validates network with networkValidator. With
+// allowUnvalidated, set from the provider's allow_unvalidated_network, a
+// network the validator does not know is only warned about and left for the
+// Streams API to validate.
+func checkNetwork(ctx context.Context, network types.String, allowUnvalidated bool) diag.Diagnostics {
+	var resp validator.StringResponse
+	networkValidator.ValidateString(ctx, validator.StringRequest{Path: path.Root("network"), ConfigValue: network}, &resp)
+
+	if !resp.Diagnostics.HasError() || !allowUnvalidated {
+		return resp.Diagnostics
+	}
+
+	var diags diag.Diagnostics
+	diags.AddAttributeWarning(
+		path.Root("network"),
+		"Unvalidated network",
+		fmt.Sprintf("Network %s is not known to this provider version. allow_unvalidated_network is set, so it is left for the Streams API to validate.", network.ValueString()),
+	)
+	return diags
+}
+
+// float32ExactIntegerLimit is the largest value below which every integer is
+// exactly representable as a float32.
+const float32ExactIntegerLimit = 1 << 24
+
+// endRangeSentinels are the integer limits the Streams API may return as the
+// end_range of a stream without one that are also valid block numbers. Larger
+// limits, such as math.MaxInt64, are above any configurable end_range.
+var endRangeSentinels = []float64{math.MaxInt32, math.MaxUint32}
+
+// unboundedEndRange reports whether an end_range read from the API stands
+// for a stream without an end. Negative values and values above the largest
+// configurable end_range are never block numbers. A sentinel that is also a
+// valid block number is only taken as one when prior is not that block.
+func unboundedEndRange(endRange float64, prior types.Int64) bool {
+	if endRange < 0 || endRange > validators.MaxEndRange {
+		return true
+	}
+
+	if !slices.Contains(endRangeSentinels, endRange) {
+		return false
+	}
+	return prior.IsNull() || prior.IsUnknown() || float64(prior.ValueInt64()) != endRange
+}
+
+// checkRangePrecision warns when start_range or end_range is beyond the range
+// a float32 holds exactly, where block numbers may be altered in transit.
+func checkRangePrecision(config StreamResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for name, value := range map[string]types.Int64{
+		"start_range": config.StartRange,
+		"end_range":   config.EndRange,
+	} {
+		if value.IsNull() || value.IsUnknown() || value.ValueInt64() <= float32ExactIntegerLimit {
+			continue
+		}
+
+		diags.AddAttributeWarning(
+			path.Root(name),
+			"Block number may lose precision",
+			fmt.Sprintf("%s %d is above %d, the largest integer a float32 holds exactly, and may be altered before it reaches QuickNode. Check the stream's %s after apply.", name, value.ValueInt64(), float32ExactIntegerLimit, name),
+		)
+	}
+
+	return diags
+}
+
+// canonicalHeaders returns headers with their names in canonical form, such
+// as Content-Type for content-type, the form the Streams API returns them in.
+func canonicalHeaders(headers map[string]interface{}) (map[string]interface{}, error) {
+	canonical := make(map[string]interface{}, len(headers))
+	names := make(map[string]string, len(headers))
+	for name, value := range headers {
+		key := http.CanonicalHeaderKey(name)
+		if other, ok := names[key]; ok {
+			return nil, fmt.Errorf("headers %q and %q are the same header, header names are case-insensitive", other, name)
+		}
+		names[key] = name
+		canonical[key] = value
+	}
+	return canonical, nil
+}
+
 // getWebhookAttributes extracts webhook attributes from the destination_attributes map.
 func getWebhookAttributes(destAttrs map[string]interface{}) (*streams.WebhookAttributes, error) {
 	url, ok := destAttrs["url"].(string)
@@ -421,6 +697,10 @@ func getWebhookAttributes(destAttrs map[string]interface{}) (*streams.WebhookAtt
 	if !ok {
 		return nil, fmt.Errorf("headers must be a map")
 	}
+	headers, err := canonicalHeaders(headers)
+	if err != nil {
+		return nil, err
+	}
 	maxRetry, ok := destAttrs["max_retry"].(int64)
 	if !ok {
 		return nil, fmt.Errorf("max_retry must be an integer")
@@ -455,6 +735,10 @@ func getS3Attributes(destAttrs map[string]interface{}) (*streams.S3Attributes, e
 	if !ok {
 		return nil, fmt.Errorf("endpoint must be a string")
 	}
+	// The Streams API expects a bare host; the scheme is carried by use_ssl.
+	if host, _, err := validators.SplitS3Endpoint(endpoint); err == nil {
+		endpoint = host
+	}
 	accessKey, ok := destAttrs["access_key"].(string)
 	if !ok {
 		return nil, fmt.Errorf("access_key must be a string")
@@ -563,6 +847,23 @@ func getPostgresAttributes(destAttrs map[string]interface{}) (*streams.PostgresA
 	}, nil
 }
 
+// unwrapDataEnvelope returns the value inside a {"data": ...} envelope, as
+// the QuickNode API wraps its responses, or body itself for the bare stream
+// objects, lists and values the Streams API returns.
+func unwrapDataEnvelope(body []byte) []byte {
+	var envelope struct {
+		Id   json.RawMessage `json:"id"`
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil || envelope.Id != nil {
+		return body
+	}
+	if len(envelope.Data) > 0 && string(envelope.Data) != "null" {
+		return envelope.Data
+	}
+	return body
+}
+
 // readStreamFromAPI reads stream data from the API and updates the provided StreamResourceModel.
 // An optional fallback model can be provided; fields absent from the API response will retain
 // their values from the fallback instead of becoming null. This guards against providers returning
@@ -574,7 +875,7 @@ func (r *StreamResource) readStreamFromAPI(ctx context.Context, streamID string,
 	}
 
 	if readResp.StatusCode() == 404 {
-		return nil, fmt.Errorf("stream not found")
+		return nil, errStreamNotFound
 	}
 
 	if readResp.StatusCode() != 200 {
@@ -582,7 +883,7 @@ func (r *StreamResource) readStreamFromAPI(ctx context.Context, streamID string,
 	}
 
 	var result map[string]interface{}
-	if err := json.Unmarshal(readResp.Body, &result); err != nil {
+	if err := json.Unmarshal(unwrapDataEnvelope(readResp.Body), &result); err != nil {
 		return nil, fmt.Errorf("error decoding response: %w", err)
 	}
 
@@ -609,7 +910,12 @@ func (r *StreamResource) readStreamFromAPI(ctx context.Context, streamID string,
 		data.StartRange = types.Int64Value(int64(startRange))
 	}
 	if endRange, ok := result["end_range"].(float64); ok {
-		if endRange == -1 {
+		var prior types.Int64
+		if len(fallback) > 0 && fallback[0] != nil {
+			prior = fallback[0].EndRange
+		}
+
+		if unboundedEndRange(endRange, prior) {
 			data.EndRange = types.Int64Null()
 		} else {
 			data.EndRange = types.Int64Value(int64(endRange))
@@ -672,7 +978,7 @@ func (r *StreamResource) readStreamFromAPI(ctx context.Context, streamID string,
 
 	// Update destination_attributes
 	if destAttrs, ok := result["destination_attributes"].(map[string]interface{}); ok {
-		obj, err := updateDestinationAttributesFromAPI(destAttrs)
+		obj, err := updateDestinationAttributesFromAPI(data.Destination.ValueString(), destAttrs)
 		if err != nil {
 			return nil, fmt.Errorf("error updating destination_attributes: %w", err)
 		}
@@ -682,7 +988,41 @@ func (r *StreamResource) readStreamFromAPI(ctx context.Context, streamID string,
 	return data, nil
 }
 
+// readStreamAfterCreate reads a newly created stream, retrying up to
+// r.readRetries times while the Streams API still reports it as missing or
+// returns it without an id, which can happen briefly after creation.
+func (r *StreamResource) readStreamAfterCreate(ctx context.Context, streamID string, fallback *StreamResourceModel) (*StreamResourceModel, error) {
+	for attempt := 0; ; attempt++ {
+		data, err := r.readStreamFromAPI(ctx, streamID, fallback)
+		if err == nil && data.Id.IsNull() {
+			err = errStreamPartial
+		}
+		if err == nil {
+			return data, nil
+		}
+
+		if attempt >= r.readRetries || (!errors.Is(err, errStreamNotFound) && !errors.Is(err, errStreamPartial)) {
+			return nil, err
+		}
+
+		tflog.Debug(ctx, "Stream not yet readable after create, retrying", map[string]interface{}{
+			"stream_id": streamID,
+			"attempt":   attempt + 1,
+			"error":     err.Error(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(utils.Jitter(r.readRetryInterval)):
+		}
+	}
+}
+
 func (r *StreamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, metrics := transport.WithRequestMetrics(ctx)
+	defer logRequestMetrics(ctx, "create", metrics)
+
 	var data StreamResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -702,62 +1042,77 @@ func (r *StreamResource) Create(ctx context.Context, req resource.CreateRequest,
 	var filterFunction string
 	if !data.FilterFunction.IsNull() {
 		filterFunction = data.FilterFunction.ValueString()
+		if err := checkFilterFunction(filterFunction); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("filter_function"), "Invalid filter_function", err.Error())
+			return
+		}
 	} else {
 		filterFunction = ""
 	}
 
-	// Convert destination_attributes to appropriate type based on destination
-	destAttrs, err := convertDestinationAttributes(data.DestinationAttributes)
-	if err != nil {
-		resp.Diagnostics.AddError("Error converting destination_attributes", err.Error())
-		return
-	}
-
-	// Create appropriate destination_attributes union type based on destination
 	var destAttrsUnion streams.CreateStreamDto_DestinationAttributes
-
-	switch data.Destination.ValueString() {
-	case "webhook":
-		webhookAttrs, err := getWebhookAttributes(destAttrs)
-		if err != nil {
-			resp.Diagnostics.AddError("Error converting destination_attributes", err.Error())
-			return
-		}
-		if err := destAttrsUnion.FromWebhookAttributes(*webhookAttrs); err != nil {
-			resp.Diagnostics.AddError("Error creating webhook destination_attributes", err.Error())
+	if !data.DestinationAttributesJson.IsNull() {
+		if err := destAttrsUnion.UnmarshalJSON([]byte(data.DestinationAttributesJson.ValueString())); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("destination_attributes_json"), "Invalid destination_attributes_json", err.Error())
 			return
 		}
-
-	case "s3":
-		s3Attrs, err := getS3Attributes(destAttrs)
+	} else {
+		// Convert destination_attributes to appropriate type based on destination
+		destAttrs, err := convertDestinationAttributes(data.DestinationAttributes)
 		if err != nil {
 			resp.Diagnostics.AddError("Error converting destination_attributes", err.Error())
 			return
 		}
-		if err := destAttrsUnion.FromS3Attributes(*s3Attrs); err != nil {
-			resp.Diagnostics.AddError("Error creating S3 destination_attributes", err.Error())
-			return
-		}
 
-	case "postgres":
-		postgresAttrs, err := getPostgresAttributes(destAttrs)
-		if err != nil {
-			resp.Diagnostics.AddError("Error converting destination_attributes", err.Error())
+		resp.Diagnostics.Append(setWriteOnlyCredentials(ctx, req.Config, destAttrs)...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		if err := destAttrsUnion.FromPostgresAttributes(*postgresAttrs); err != nil {
-			resp.Diagnostics.AddError("Error creating Postgres destination_attributes", err.Error())
+
+		// Create appropriate destination_attributes union type based on destination
+		switch data.Destination.ValueString() {
+		case "webhook":
+			webhookAttrs, err := getWebhookAttributes(destAttrs)
+			if err != nil {
+				resp.Diagnostics.AddError("Error converting destination_attributes", err.Error())
+				return
+			}
+			if err := destAttrsUnion.FromWebhookAttributes(*webhookAttrs); err != nil {
+				resp.Diagnostics.AddError("Error creating webhook destination_attributes", err.Error())
+				return
+			}
+
+		case "s3":
+			s3Attrs, err := getS3Attributes(destAttrs)
+			if err != nil {
+				resp.Diagnostics.AddError("Error converting destination_attributes", err.Error())
+				return
+			}
+			if err := destAttrsUnion.FromS3Attributes(*s3Attrs); err != nil {
+				resp.Diagnostics.AddError("Error creating S3 destination_attributes", err.Error())
+				return
+			}
+
+		case "postgres":
+			postgresAttrs, err := getPostgresAttributes(destAttrs)
+			if err != nil {
+				resp.Diagnostics.AddError("Error converting destination_attributes", err.Error())
+				return
+			}
+			if err := destAttrsUnion.FromPostgresAttributes(*postgresAttrs); err != nil {
+				resp.Diagnostics.AddError("Error creating Postgres destination_attributes", err.Error())
+				return
+			}
+
+		default:
+			resp.Diagnostics.AddError("Unsupported destination type", fmt.Sprintf("Destination type '%s' is not supported", data.Destination.ValueString()))
 			return
 		}
-
-	default:
-		resp.Diagnostics.AddError("Unsupported destination type", fmt.Sprintf("Destination type '%s' is not supported", data.Destination.ValueString()))
-		return
 	}
 
 	createResp, err := r.client.CreateWithResponse(ctx, streams.CreateJSONRequestBody{
 		Name:                  data.Name.ValueString(),
-		Network:               streams.CreateStreamDtoNetwork(data.Network.ValueString()),
+		Network:               streams.CreateStreamDtoNetwork(canonicalNetwork(data.Network)),
 		Dataset:               streams.CreateStreamDtoDataset(data.Dataset.ValueString()),
 		StartRange:            startRangePtr,
 		DatasetBatchSize: datasetBatchSize,
@@ -787,14 +1142,14 @@ func (r *StreamResource) Create(ctx context.Context, req resource.CreateRequest,
 			"response_body": string(createResp.Body),
 			"request_data": map[string]interface{}{
 				"name":        data.Name.ValueString(),
-				"network":     data.Network.ValueString(),
+				"network":     canonicalNetwork(data.Network),
 				"dataset":     data.Dataset.ValueString(),
 				"destination": data.Destination.ValueString(),
 				"region":      data.Region.ValueString(),
 			},
 		})
 
-		m, err := utils.BuildRequestErrorMessage(createResp.Status(), createResp.Body)
+		m, err := utils.BuildRequestErrorMessage(createResp.Status(), createResp.Body, r.verboseErrors)
 		if err != nil {
 			resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Creating Stream", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
 		}
@@ -808,7 +1163,7 @@ func (r *StreamResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Parse response and set ID
 	var response map[string]interface{}
-	if err := json.Unmarshal(createResp.Body, &response); err != nil {
+	if err := json.Unmarshal(unwrapDataEnvelope(createResp.Body), &response); err != nil {
 		resp.Diagnostics.AddError("Error parsing response", fmt.Sprintf("Could not parse response from API: %v", err))
 		return
 	}
@@ -823,7 +1178,7 @@ func (r *StreamResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Read full stream data from API to get computed fields.
 	// Pass the current plan as fallback so that fields the QuickNode API no longer returns
 	// in GET responses (e.g. include_stream_metadata) are preserved from the plan value.
-	fullStreamData, err := r.readStreamFromAPI(ctx, data.Id.ValueString(), &data)
+	fullStreamData, err := r.readStreamAfterCreate(ctx, data.Id.ValueString(), &data)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading stream", err.Error())
 		return
@@ -831,7 +1186,7 @@ func (r *StreamResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Update data with computed fields from API
 	data.Name = fullStreamData.Name
-	data.Network = fullStreamData.Network
+	data.Network = storedNetwork(fullStreamData.Network, data.Network)
 	data.Dataset = fullStreamData.Dataset
 	if data.StartRange.IsNull() {
 		data.StartRange = fullStreamData.StartRange
@@ -844,7 +1199,7 @@ func (r *StreamResource) Create(ctx context.Context, req resource.CreateRequest,
 	data.ElasticBatchEnabled = fullStreamData.ElasticBatchEnabled
 	data.Region = fullStreamData.Region
 	data.FilterFunction = fullStreamData.FilterFunction
-	data.DestinationAttributes = fullStreamData.DestinationAttributes
+	data.DestinationAttributes = storedDestinationAttributes(fullStreamData.DestinationAttributes, data)
 
 	tflog.Trace(ctx, "created a resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -857,7 +1212,29 @@ func (r *StreamResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	res, err := r.client.RemoveWithResponse(ctx, data.Id.ValueString())
+	deleteCtx := ctx
+	if r.deleteTimeout > 0 {
+		var cancel context.CancelFunc
+		deleteCtx, cancel = context.WithTimeout(ctx, r.deleteTimeout)
+		defer cancel()
+	}
+
+	res, err := r.client.RemoveWithResponse(deleteCtx, data.Id.ValueString())
+	if err != nil && ctx.Err() != nil {
+		resp.Diagnostics.AddError(
+			"Stream Deletion Canceled",
+			fmt.Sprintf("Deleting stream %s was canceled before it completed: %v", data.Id.ValueString(), ctx.Err()),
+		)
+		return
+	}
+	if err != nil && errors.Is(deleteCtx.Err(), context.DeadlineExceeded) {
+		resp.Diagnostics.AddError(
+			"Stream Deletion Timed Out",
+			fmt.Sprintf("Deleting stream %s did not complete within %s. The stream may still be deleted by QuickNode; "+
+				"run terraform apply again to retry, or raise the provider's stream_delete_timeout_sec.", data.Id.ValueString(), r.deleteTimeout),
+		)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("%s - Deleting Stream", utils.ClientErrorSummary),
@@ -867,7 +1244,7 @@ func (r *StreamResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	if res.StatusCode() != 200 {
-		m, err := utils.BuildRequestErrorMessage(res.Status(), res.Body)
+		m, err := utils.BuildRequestErrorMessage(res.Status(), res.Body, r.verboseErrors)
 		if err != nil {
 			resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Deleting Stream", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
 		}
@@ -878,6 +1255,50 @@ func (r *StreamResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		)
 		return
 	}
+
+	if r.deleteWaitTimeout > 0 {
+		if err := r.waitForStreamDeleted(ctx, data.Id.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Error waiting for stream deletion", err.Error())
+		}
+	}
+}
+
+// waitForStreamDeleted polls a deleted stream until the Streams API reports it
+// missing, doubling the jittered interval between reads up to
+// streamDeletePollIntervalMax, for at most r.deleteWaitTimeout. Deletion can
+// complete asynchronously, and recreating a stream before it does can collide
+// with the one still being removed.
+func (r *StreamResource) waitForStreamDeleted(ctx context.Context, streamID string) error {
+	deadline := time.Now().Add(r.deleteWaitTimeout)
+	interval := r.deletePollInterval
+
+	for {
+		_, err := r.readStreamFromAPI(ctx, streamID)
+		if errors.Is(err, errStreamNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return fmt.Errorf("stream %s was still present %s after it was deleted", streamID, r.deleteWaitTimeout)
+		}
+
+		tflog.Debug(ctx, "Stream still present after delete, polling", map[string]interface{}{
+			"stream_id": streamID,
+			"interval":  interval.String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(min(utils.Jitter(interval), remaining)):
+		}
+
+		interval = min(interval*2, streamDeletePollIntervalMax)
+	}
 }
 
 func (r *StreamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -893,7 +1314,7 @@ func (r *StreamResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// becoming null, which would otherwise cause phantom diffs on every plan/apply cycle.
 	streamData, err := r.readStreamFromAPI(ctx, data.Id.ValueString(), &data)
 	if err != nil {
-		if strings.Contains(err.Error(), "stream not found") {
+		if errors.Is(err, errStreamNotFound) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -906,7 +1327,7 @@ func (r *StreamResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	// Update state with data from API
 	data.Name = streamData.Name
-	data.Network = streamData.Network
+	data.Network = storedNetwork(streamData.Network, data.Network)
 	data.Dataset = streamData.Dataset
 	data.StartRange = streamData.StartRange
 	data.EndRange = streamData.EndRange
@@ -920,12 +1341,15 @@ func (r *StreamResource) Read(ctx context.Context, req resource.ReadRequest, res
 	data.FixBlockReorgs = streamData.FixBlockReorgs
 	data.KeepDistanceFromTip = streamData.KeepDistanceFromTip
 	data.NotificationEmail = streamData.NotificationEmail
-	data.DestinationAttributes = streamData.DestinationAttributes
+	data.DestinationAttributes = storedDestinationAttributes(streamData.DestinationAttributes, data)
 
 	resp.State.Set(ctx, &data)
 }
 
 func (r *StreamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, metrics := transport.WithRequestMetrics(ctx)
+	defer logRequestMetrics(ctx, "update", metrics)
+
 	var plan StreamResourceModel
 	var state StreamResourceModel
 
@@ -946,6 +1370,14 @@ func (r *StreamResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// Check the filter before pausing so a bad filter leaves the stream untouched.
+	if !plan.FilterFunction.IsNull() {
+		if err := checkFilterFunction(plan.FilterFunction.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("filter_function"), "Invalid filter_function", err.Error())
+			return
+		}
+	}
+
 	tflog.Info(ctx, "Starting stream update", map[string]interface{}{
 		"stream_id": streamId,
 		"name":      plan.Name.ValueString(),
@@ -986,7 +1418,7 @@ func (r *StreamResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 
 		if pauseResp.StatusCode() != 200 && pauseResp.StatusCode() != 201 {
-			m, err := utils.BuildRequestErrorMessage(pauseResp.Status(), pauseResp.Body)
+			m, err := utils.BuildRequestErrorMessage(pauseResp.Status(), pauseResp.Body, r.verboseErrors)
 			if err != nil {
 				resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Pausing Stream", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
 			}
@@ -1031,6 +1463,11 @@ func (r *StreamResource) Update(ctx context.Context, req resource.UpdateRequest,
 			return
 		}
 
+		resp.Diagnostics.Append(setWriteOnlyCredentials(ctx, req.Config, destAttrs)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		// Create appropriate destination_attributes union type based on destination
 		var union streams.UpdateStreamDto_DestinationAttributes
 
@@ -1073,6 +1510,14 @@ func (r *StreamResource) Update(ctx context.Context, req resource.UpdateRequest,
 			return
 		}
 
+		destAttrsUnion = &union
+	} else if !plan.DestinationAttributesJson.IsNull() {
+		var union streams.UpdateStreamDto_DestinationAttributes
+		if err := union.UnmarshalJSON([]byte(plan.DestinationAttributesJson.ValueString())); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("destination_attributes_json"), "Invalid destination_attributes_json", err.Error())
+			return
+		}
+
 		destAttrsUnion = &union
 	}
 
@@ -1118,7 +1563,7 @@ func (r *StreamResource) Update(ctx context.Context, req resource.UpdateRequest,
 			},
 		})
 
-		m, err := utils.BuildRequestErrorMessage(updateResp.Status(), updateResp.Body)
+		m, err := utils.BuildRequestErrorMessage(updateResp.Status(), updateResp.Body, r.verboseErrors)
 		if err != nil {
 			resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Updating Stream", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
 		}
@@ -1134,7 +1579,10 @@ func (r *StreamResource) Update(ctx context.Context, req resource.UpdateRequest,
 		"stream_id": streamId,
 	})
 
-	// If stream was active before update, reactivate it
+	// If stream was active before update, reactivate it. A failed reactivation
+	// is still reported as an error, but the update itself went through, so
+	// state is saved with the stream paused for the next plan to converge.
+	var reactivationFailed bool
 	if wasActive {
 		tflog.Info(ctx, "Reactivating stream after update", map[string]interface{}{
 			"stream_id": streamId,
@@ -1146,11 +1594,9 @@ func (r *StreamResource) Update(ctx context.Context, req resource.UpdateRequest,
 				fmt.Sprintf("%s - Activating Stream", utils.ClientErrorSummary),
 				utils.BuildClientErrorMessage(err),
 			)
-			return
-		}
-
-		if activateResp.StatusCode() != 200 && activateResp.StatusCode() != 201 {
-			m, err := utils.BuildRequestErrorMessage(activateResp.Status(), activateResp.Body)
+			reactivationFailed = true
+		} else if activateResp.StatusCode() != 200 && activateResp.StatusCode() != 201 {
+			m, err := utils.BuildRequestErrorMessage(activateResp.Status(), activateResp.Body, r.verboseErrors)
 			if err != nil {
 				resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Activating Stream", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
 			}
@@ -1159,12 +1605,12 @@ func (r *StreamResource) Update(ctx context.Context, req resource.UpdateRequest,
 				fmt.Sprintf("%s - Activating Stream", utils.RequestErrorSummary),
 				m,
 			)
-			return
+			reactivationFailed = true
+		} else {
+			tflog.Info(ctx, "Stream reactivated successfully", map[string]interface{}{
+				"stream_id": streamId,
+			})
 		}
-
-		tflog.Info(ctx, "Stream reactivated successfully", map[string]interface{}{
-			"stream_id": streamId,
-		})
 	}
 
 	// Read full stream data from API to get computed fields.
@@ -1180,7 +1626,7 @@ func (r *StreamResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Update plan with computed fields from API
 	plan.Id = fullStreamData.Id
 	plan.Name = fullStreamData.Name
-	plan.Network = fullStreamData.Network
+	plan.Network = storedNetwork(fullStreamData.Network, plan.Network)
 	plan.Dataset = fullStreamData.Dataset
 	plan.StartRange = fullStreamData.StartRange
 	plan.EndRange = fullStreamData.EndRange
@@ -1194,7 +1640,11 @@ func (r *StreamResource) Update(ctx context.Context, req resource.UpdateRequest,
 	plan.FixBlockReorgs = fullStreamData.FixBlockReorgs
 	plan.KeepDistanceFromTip = fullStreamData.KeepDistanceFromTip
 	plan.NotificationEmail = fullStreamData.NotificationEmail
-	plan.DestinationAttributes = fullStreamData.DestinationAttributes
+	plan.DestinationAttributes = storedDestinationAttributes(fullStreamData.DestinationAttributes, plan)
+
+	if reactivationFailed {
+		plan.Status = types.StringValue("paused")
+	}
 
 	// Save updated state
 	resp.State.Set(ctx, &plan)
@@ -1204,8 +1654,71 @@ func (r *StreamResource) ImportState(ctx context.Context, req resource.ImportSta
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-// updateDestinationAttributesFromAPI converts destination_attributes from API to Terraform format.
-func updateDestinationAttributesFromAPI(destAttrs map[string]interface{}) (types.Object, error) {
+// destinationAttributesType is the object type of the destination_attributes attribute.
+var destinationAttributesType = map[string]attr.Type{
+	"url":                types.StringType,
+	"compression":        types.StringType,
+	"headers":            types.MapType{ElemType: types.StringType},
+	"max_retry":          types.Int64Type,
+	"retry_interval_sec": types.Int64Type,
+	"post_timeout_sec":   types.Int64Type,
+	"security_token":     types.StringType,
+	"version":            types.StringType,
+	"access_key":         types.StringType,
+	"secret_key":         types.StringType,
+	"bucket":             types.StringType,
+	"region":             types.StringType,
+	"endpoint":           types.StringType,
+	"object_prefix":      types.StringType,
+	"use_ssl":            types.BoolType,
+	"file_compression":   types.StringType,
+	"file_type":          types.StringType,
+	"username":           types.StringType,
+	"password":           types.StringType,
+	"host":               types.StringType,
+	"port":               types.Int64Type,
+	"database":           types.StringType,
+	"table_name":         types.StringType,
+	"sslmode":            types.StringType,
+
+	"access_key_wo":          types.StringType,
+	"secret_key_wo":          types.StringType,
+	"password_wo":            types.StringType,
+	"credentials_wo_version": types.Int64Type,
+}
+
+// nullDestinationAttributes returns every field of destinationAttributesType
+// set to null.
+func nullDestinationAttributes() map[string]attr.Value {
+	values := make(map[string]attr.Value, len(destinationAttributesType))
+	for name, typ := range destinationAttributesType {
+		switch typ {
+		case types.StringType:
+			values[name] = types.StringNull()
+		case types.Int64Type:
+			values[name] = types.Int64Null()
+		case types.BoolType:
+			values[name] = types.BoolNull()
+		default:
+			values[name] = types.MapNull(types.StringType)
+		}
+	}
+	return values
+}
+
+// destinationAttributeKeys lists the destination_attributes fields that belong
+// to each destination. Fields the API returns for another destination are left
+// null so an imported stream matches one created from configuration.
+// Destinations absent from the map keep every field the API returns.
+var destinationAttributeKeys = map[string][]string{
+	"webhook":  {"url", "compression", "headers", "max_retry", "retry_interval_sec", "post_timeout_sec", "security_token", "version"},
+	"s3":       {"endpoint", "access_key", "secret_key", "bucket", "region", "object_prefix", "file_compression", "file_type", "max_retry", "retry_interval_sec", "use_ssl"},
+	"postgres": {"username", "password", "host", "port", "database", "access_key", "sslmode", "table_name", "max_retry", "retry_interval_sec"},
+}
+
+// updateDestinationAttributesFromAPI converts destination_attributes from API to Terraform format,
+// keeping only the fields relevant to destination.
+func updateDestinationAttributesFromAPI(destination string, destAttrs map[string]interface{}) (types.Object, error) {
 	attrs := make(map[string]attr.Value)
 
 	// Initialize all required fields with null values
@@ -1233,9 +1746,24 @@ func updateDestinationAttributesFromAPI(destAttrs map[string]interface{}) (types
 	attrs["database"] = types.StringNull()
 	attrs["table_name"] = types.StringNull()
 	attrs["sslmode"] = types.StringNull()
+	attrs["access_key_wo"] = types.StringNull()
+	attrs["secret_key_wo"] = types.StringNull()
+	attrs["password_wo"] = types.StringNull()
+	attrs["credentials_wo_version"] = types.Int64Null()
+
+	keys, filtered := destinationAttributeKeys[destination]
 
 	// Update with actual values from API
 	for k, v := range destAttrs {
+		if filtered && !slices.Contains(keys, k) {
+			continue
+		}
+		// Fields of destinations only configurable through
+		// destination_attributes_json have no attribute to hold them.
+		if _, ok := destinationAttributesType[k]; !ok {
+			continue
+		}
+
 		switch val := v.(type) {
 		case string:
 			// Treat empty strings as null for optional fields that are not relevant for this destination type
@@ -1265,34 +1793,7 @@ func updateDestinationAttributesFromAPI(destAttrs map[string]interface{}) (types
 		}
 	}
 
-	objType := map[string]attr.Type{
-		"url":                types.StringType,
-		"compression":        types.StringType,
-		"headers":            types.MapType{ElemType: types.StringType},
-		"max_retry":          types.Int64Type,
-		"retry_interval_sec": types.Int64Type,
-		"post_timeout_sec":   types.Int64Type,
-		"security_token":     types.StringType,
-		"version":            types.StringType,
-		"access_key":         types.StringType,
-		"secret_key":         types.StringType,
-		"bucket":             types.StringType,
-		"region":             types.StringType,
-		"endpoint":           types.StringType,
-		"object_prefix":      types.StringType,
-		"use_ssl":            types.BoolType,
-		"file_compression":   types.StringType,
-		"file_type":          types.StringType,
-		"username":           types.StringType,
-		"password":           types.StringType,
-		"host":               types.StringType,
-		"port":               types.Int64Type,
-		"database":           types.StringType,
-		"table_name":         types.StringType,
-		"sslmode":            types.StringType,
-	}
-
-	obj, diags := types.ObjectValue(objType, attrs)
+	obj, diags := types.ObjectValue(destinationAttributesType, attrs)
 	if diags.HasError() {
 		return types.Object{}, fmt.Errorf("error creating destination_attributes object: %v", diags)
 	}
@@ -1300,31 +1801,289 @@ func updateDestinationAttributesFromAPI(destAttrs map[string]interface{}) (types
 	return obj, nil
 }
 
+// sensitiveDestinationAttributes are the destination credentials users may omit
+// from configuration once the stream has been created with them.
+var sensitiveDestinationAttributes = []string{"access_key", "secret_key", "password"}
+
+// preserveOmittedSecret plans a credential left out of the configuration as
+// its prior state value, so it is kept on the stream and in state rather than
+// cleared. It is planned as null without prior state, when its write-only
+// attribute is set instead, or when the destination changes.
+type preserveOmittedSecret struct{}
+
+func (m preserveOmittedSecret) Description(ctx context.Context) string {
+	return "Keeps the prior value of a credential omitted from the configuration."
+}
+
+func (m preserveOmittedSecret) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m preserveOmittedSecret) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() || m.superseded(ctx, req) {
+		resp.PlanValue = types.StringNull()
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+// superseded reports whether the prior value of the credential no longer
+// applies: its write-only attribute is configured, or the destination it was
+// set for changes.
+func (m preserveOmittedSecret) superseded(ctx context.Context, req planmodifier.StringRequest) bool {
+	if req.Config.Raw.IsNull() || req.State.Raw.IsNull() {
+		return false
+	}
+
+	name, _ := req.Path.Steps().LastStep()
+	for writeOnly, credential := range writeOnlyCredentials {
+		if !name.Equal(path.PathStepAttributeName(credential)) {
+			continue
+		}
+
+		var value types.String
+		req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName(writeOnly), &value)
+		if !value.IsNull() {
+			return true
+		}
+	}
+
+	var configDestination, stateDestination types.String
+	req.Config.GetAttribute(ctx, path.Root("destination"), &configDestination)
+	req.State.GetAttribute(ctx, path.Root("destination"), &stateDestination)
+	return !configDestination.Equal(stateDestination)
+}
+
+// reconcileDestinationAttributes adjusts the destination_attributes read from
+// the API to match prior, the plan or state they replace, where the API
+// stores a value differently from how it was configured.
+func reconcileDestinationAttributes(read, prior types.Object) types.Object {
+	if read.IsNull() || read.IsUnknown() || prior.IsNull() || prior.IsUnknown() {
+		return read
+	}
+
+	read = withoutWriteOnlyCredentials(read, prior)
+
+	// Credentials the API does not return are kept from prior, so a credential
+	// carried over from state is not lost after the update that sent it.
+	attrs := read.Attributes()
+	priorAttrs := prior.Attributes()
+	for _, k := range sensitiveDestinationAttributes {
+		readVal, _ := attrs[k].(types.String)
+		priorVal, _ := priorAttrs[k].(types.String)
+		if readVal.IsNull() && !priorVal.IsNull() && !priorVal.IsUnknown() {
+			attrs[k] = priorVal
+		}
+	}
+	read = types.ObjectValueMust(destinationAttributesType, attrs)
+
+	// Header names are stored as configured, which may differ in case from
+	// the canonical names the API returns.
+	attrs = read.Attributes()
+	readHeaders, _ := attrs["headers"].(types.Map)
+	priorHeaders, _ := prior.Attributes()["headers"].(types.Map)
+	attrs["headers"] = storedHeaders(readHeaders, priorHeaders)
+	read = types.ObjectValueMust(destinationAttributesType, attrs)
+
+	// An endpoint configured as a URL is stored as its host.
+	attrs = read.Attributes()
+	readEndpoint, _ := attrs["endpoint"].(types.String)
+	priorEndpoint, _ := prior.Attributes()["endpoint"].(types.String)
+	if !readEndpoint.IsNull() && !priorEndpoint.IsNull() && !priorEndpoint.IsUnknown() {
+		if host, _, err := validators.SplitS3Endpoint(priorEndpoint.ValueString()); err == nil && host == readEndpoint.ValueString() {
+			attrs["endpoint"] = priorEndpoint
+			read = types.ObjectValueMust(destinationAttributesType, attrs)
+		}
+	}
+
+	return read
+}
+
+// storedHeaders returns the headers to store for a webhook whose headers were
+// read back as read, keeping the name of each header as it was in prior.
+func storedHeaders(read, prior types.Map) types.Map {
+	if read.IsNull() || read.IsUnknown() || prior.IsNull() || prior.IsUnknown() {
+		return read
+	}
+
+	priorNames := make(map[string]string, len(prior.Elements()))
+	for name := range prior.Elements() {
+		priorNames[http.CanonicalHeaderKey(name)] = name
+	}
+
+	headers := make(map[string]attr.Value, len(read.Elements()))
+	for name, value := range read.Elements() {
+		if priorName, ok := priorNames[http.CanonicalHeaderKey(name)]; ok {
+			name = priorName
+		}
+		headers[name] = value
+	}
+	return types.MapValueMust(types.StringType, headers)
+}
+
+// storedDestinationAttributes returns the destination_attributes to store for a
+// stream whose attributes were read back as read. Streams configured with
+// destination_attributes_json keep destination_attributes null, and the JSON
+// is kept as configured since the API fills in fields it was not given.
+func storedDestinationAttributes(read types.Object, prior StreamResourceModel) types.Object {
+	if !prior.DestinationAttributesJson.IsNull() {
+		return types.ObjectNull(destinationAttributesType)
+	}
+
+	return reconcileDestinationAttributes(read, prior.DestinationAttributes)
+}
+
+// canonicalNetwork returns the slug the Streams API expects for a configured
+// network, which may be in any case.
+func canonicalNetwork(network types.String) string {
+	return strings.ToLower(network.ValueString())
+}
+
+// storedNetwork returns the network, or endpoint chain, to store when it was
+// read back as read. A prior value differing only in case is kept, since
+// Terraform requires state to match the configuration.
+func storedNetwork(read, prior types.String) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() && strings.EqualFold(prior.ValueString(), read.ValueString()) {
+		return prior
+	}
+
+	return read
+}
+
+func requiresReplaceIfNetworkChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !strings.EqualFold(req.StateValue.ValueString(), req.PlanValue.ValueString())
+}
+
+func requiresReplaceIfTriggersChanged(ctx context.Context, req planmodifier.MapRequest, resp *mapplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.Equal(req.PlanValue)
+}
+
+// writeOnlyCredentials maps each write-only destination attribute to the
+// credential it sets.
+var writeOnlyCredentials = map[string]string{
+	"access_key_wo": "access_key",
+	"secret_key_wo": "secret_key",
+	"password_wo":   "password",
+}
+
+// setWriteOnlyCredentials sets the credentials given by write-only attributes
+// in destAttrs. Write-only values are only available from the configuration,
+// never from the plan or state.
+func setWriteOnlyCredentials(ctx context.Context, config tfsdk.Config, destAttrs map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if config.Raw.IsNull() {
+		return diags
+	}
+
+	for writeOnly, credential := range writeOnlyCredentials {
+		var value types.String
+		diags.Append(config.GetAttribute(ctx, path.Root("destination_attributes").AtName(writeOnly), &value)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if !value.IsNull() && !value.IsUnknown() {
+			destAttrs[credential] = value.ValueString()
+		}
+	}
+
+	return diags
+}
+
+// withoutWriteOnlyCredentials returns the destination_attributes read from the
+// API with the credentials prior does not hold set to null, so credentials sent
+// through write-only attributes are not stored in state. credentials_wo_version
+// is not returned by the API and is carried over from prior. A null prior, as
+// on import, keeps everything the API returned.
+func withoutWriteOnlyCredentials(read, prior types.Object) types.Object {
+	if read.IsNull() || read.IsUnknown() || prior.IsNull() || prior.IsUnknown() {
+		return read
+	}
+
+	attrs := read.Attributes()
+	priorAttrs := prior.Attributes()
+	for _, credential := range writeOnlyCredentials {
+		if v, ok := priorAttrs[credential]; ok && v.IsNull() {
+			attrs[credential] = types.StringNull()
+		}
+	}
+	if v, ok := priorAttrs["credentials_wo_version"]; ok {
+		attrs["credentials_wo_version"] = v
+	}
+
+	return types.ObjectValueMust(destinationAttributesType, attrs)
+}
+
 // convertDestinationAttributes converts destination_attributes from Terraform to API format.
 func convertDestinationAttributes(attrs types.Object) (map[string]interface{}, error) {
 	destAttrs := make(map[string]interface{})
 	attributes := attrs.Attributes()
 
 	for k, v := range attributes {
-		switch val := v.(type) {
-		case types.String:
-			destAttrs[k] = val.ValueString()
-		case types.Int64:
-			destAttrs[k] = val.ValueInt64()
-		case types.Bool:
-			destAttrs[k] = val.ValueBool()
-		case types.Map:
-			headers := make(map[string]interface{})
-			elements := val.Elements()
-			for key, value := range elements {
-				if strVal, ok := value.(types.String); ok {
-					headers[key] = strVal.ValueString()
-				}
-			}
-			destAttrs[k] = headers
-		default:
-			return nil, fmt.Errorf("unsupported attribute type %s: %T", k, val)
+		value, err := convertDestinationAttributeValue(path.Root("destination_attributes").AtName(k), v)
+		if err != nil {
+			return nil, err
 		}
+		destAttrs[k] = value
 	}
 	return destAttrs, nil
 }
+
+// convertDestinationAttributeValue converts the destination_attributes value
+// at p to API format, descending into collections and objects.
+func convertDestinationAttributeValue(p path.Path, v attr.Value) (interface{}, error) {
+	switch val := v.(type) {
+	case types.String:
+		return val.ValueString(), nil
+	case types.Int64:
+		return val.ValueInt64(), nil
+	case types.Bool:
+		return val.ValueBool(), nil
+	case types.Float64:
+		return val.ValueFloat64(), nil
+	case types.Map:
+		values := make(map[string]interface{}, len(val.Elements()))
+		for key, element := range val.Elements() {
+			value, err := convertDestinationAttributeValue(p.AtMapKey(key), element)
+			if err != nil {
+				return nil, err
+			}
+			values[key] = value
+		}
+		return values, nil
+	case types.Object:
+		values := make(map[string]interface{}, len(val.Attributes()))
+		for name, attribute := range val.Attributes() {
+			value, err := convertDestinationAttributeValue(p.AtName(name), attribute)
+			if err != nil {
+				return nil, err
+			}
+			values[name] = value
+		}
+		return values, nil
+	case types.List:
+		return convertDestinationAttributeElements(p, val.Elements())
+	case types.Set:
+		return convertDestinationAttributeElements(p, val.Elements())
+	default:
+		return nil, fmt.Errorf("%s has unsupported value type %T", p, v)
+	}
+}
+
+func convertDestinationAttributeElements(p path.Path, elements []attr.Value) ([]interface{}, error) {
+	values := make([]interface{}, 0, len(elements))
+	for i, element := range elements {
+		value, err := convertDestinationAttributeValue(p.AtListIndex(i), element)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
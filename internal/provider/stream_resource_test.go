@@ -17,11 +17,29 @@
 package provider
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"math"
+	"math/big"
+	"net/http"
 	"os"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/circlefin/terraform-provider-quicknode/api/streams"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -155,3 +173,1353 @@ resource "quicknode_stream" "main" {
 	}
 }`, name, destination)
 }
+
+func testWebhookDestinationAttributes() map[string]interface{} {
+	return map[string]interface{}{
+		"url":                "https://example.com/hook",
+		"compression":        "none",
+		"headers":            map[string]interface{}{},
+		"max_retry":          int64(3),
+		"retry_interval_sec": int64(1),
+		"post_timeout_sec":   int64(30),
+		"security_token":     "",
+	}
+}
+
+func TestGetWebhookAttributes_UserSuppliedSecurityToken(t *testing.T) {
+	destAttrs := testWebhookDestinationAttributes()
+	destAttrs["security_token"] = "0123456789abcdef0123456789abcdef"
+
+	webhookAttrs, err := getWebhookAttributes(destAttrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if webhookAttrs.SecurityToken != "0123456789abcdef0123456789abcdef" {
+		t.Errorf("expected security token to be passed through, got %q", webhookAttrs.SecurityToken)
+	}
+}
+
+func TestGetWebhookAttributes_OmittedSecurityToken(t *testing.T) {
+	// An omitted token is sent as empty so QuickNode generates one.
+	webhookAttrs, err := getWebhookAttributes(testWebhookDestinationAttributes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if webhookAttrs.SecurityToken != "" {
+		t.Errorf("expected empty security token, got %q", webhookAttrs.SecurityToken)
+	}
+}
+
+func TestUpdateDestinationAttributesFromAPI_GeneratedSecurityToken(t *testing.T) {
+	obj, err := updateDestinationAttributesFromAPI("webhook", map[string]interface{}{
+		"url":            "https://example.com/hook",
+		"security_token": "generated-by-quicknode-0123456789abcdef",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := obj.Attributes()["security_token"]
+	if !got.Equal(types.StringValue("generated-by-quicknode-0123456789abcdef")) {
+		t.Errorf("expected generated security token to round-trip, got %v", got)
+	}
+}
+
+func TestDestinationCompressionFieldsStaySeparate(t *testing.T) {
+	webhookAttrs := testWebhookDestinationAttributes()
+	webhookAttrs["compression"] = "gzip"
+	webhookAttrs["file_compression"] = "zstd"
+
+	webhook, err := getWebhookAttributes(webhookAttrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body, _ := json.Marshal(webhook); strings.Contains(string(body), "file_compression") || !strings.Contains(string(body), `"compression":"gzip"`) {
+		t.Errorf("expected webhook attributes to carry only compression, got %s", body)
+	}
+
+	s3, err := getS3Attributes(map[string]interface{}{
+		"endpoint":           "s3.amazonaws.com",
+		"access_key":         "access",
+		"secret_key":         "secret",
+		"bucket":             "bucket",
+		"object_prefix":      "streams/",
+		"compression":        "gzip",
+		"file_compression":   "zstd",
+		"file_type":          ".json",
+		"max_retry":          int64(3),
+		"retry_interval_sec": int64(1),
+		"use_ssl":            true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body, _ := json.Marshal(s3); strings.Contains(string(body), `"compression"`) || !strings.Contains(string(body), `"file_compression":"zstd"`) {
+		t.Errorf("expected s3 attributes to carry only file_compression, got %s", body)
+	}
+
+	for destination, kept := range map[string]string{"webhook": "compression", "s3": "file_compression"} {
+		obj, err := updateDestinationAttributesFromAPI(destination, map[string]interface{}{
+			"compression":      "gzip",
+			"file_compression": "zstd",
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", destination, err)
+		}
+		for _, attribute := range []string{"compression", "file_compression"} {
+			if got := obj.Attributes()[attribute]; got.IsNull() != (attribute != kept) {
+				t.Errorf("%s: expected only %s to be read back, got %s = %v", destination, kept, attribute, got)
+			}
+		}
+	}
+}
+
+// testStreamModel builds a StreamResourceModel for the given destination with
+// the supplied destination_attributes set and every other field null.
+func testStreamModel(t *testing.T, destination string, attrs map[string]attr.Value) StreamResourceModel {
+	t.Helper()
+
+	values := nullDestinationAttributes()
+	for name, value := range attrs {
+		values[name] = value
+	}
+
+	obj, diags := types.ObjectValue(destinationAttributesType, values)
+	if diags.HasError() {
+		t.Fatalf("building destination_attributes: %v", diags)
+	}
+
+	return StreamResourceModel{
+		Destination:           types.StringValue(destination),
+		DestinationAttributes: obj,
+		ReplaceTriggers:       types.MapNull(types.StringType),
+	}
+}
+
+// streamStubClient embeds the full streams ClientWithResponsesInterface so it
+// satisfies the type without hand-rolling every method. Calls the tests do not
+// stub panic via the nil embedded interface.
+type streamStubClient struct {
+	streams.ClientWithResponsesInterface
+
+	// stream is returned as the body of FindOne.
+	stream map[string]interface{}
+	// findOneStatuses are returned by successive FindOne calls before
+	// falling back to 200.
+	findOneStatuses []int
+	findOneCalls    int
+
+	createBodies []streams.CreateJSONRequestBody
+	updateBodies []streams.UpdateJSONRequestBody
+	pauseCalls   int
+	removeCalls  int
+
+	// activateStatus is returned by ActivateStream, 200 when unset.
+	activateStatus int
+
+	// removeBlocks makes Remove wait until its context is done, like a
+	// request that never gets a response.
+	removeBlocks bool
+}
+
+func testStubResponse(status int) *http.Response {
+	return &http.Response{StatusCode: status, Status: http.StatusText(status)}
+}
+
+func (s *streamStubClient) FindOneWithResponse(_ context.Context, _ string, _ ...streams.RequestEditorFn) (*streams.FindOneResponse, error) {
+	s.findOneCalls++
+	if len(s.findOneStatuses) > 0 {
+		status := s.findOneStatuses[0]
+		s.findOneStatuses = s.findOneStatuses[1:]
+		if status != http.StatusOK {
+			return &streams.FindOneResponse{HTTPResponse: testStubResponse(status), Body: []byte(`{}`)}, nil
+		}
+	}
+
+	body, err := json.Marshal(s.stream)
+	if err != nil {
+		return nil, err
+	}
+	return &streams.FindOneResponse{HTTPResponse: testStubResponse(http.StatusOK), Body: body}, nil
+}
+
+func (s *streamStubClient) CreateWithResponse(_ context.Context, body streams.CreateJSONRequestBody, _ ...streams.RequestEditorFn) (*streams.CreateResponse, error) {
+	s.createBodies = append(s.createBodies, body)
+	return &streams.CreateResponse{HTTPResponse: testStubResponse(http.StatusCreated), Body: []byte(`{"id":"stream-123"}`)}, nil
+}
+
+func (s *streamStubClient) UpdateWithResponse(_ context.Context, _ string, body streams.UpdateJSONRequestBody, _ ...streams.RequestEditorFn) (*streams.UpdateResponse, error) {
+	s.updateBodies = append(s.updateBodies, body)
+	return &streams.UpdateResponse{HTTPResponse: testStubResponse(http.StatusOK), Body: []byte(`{}`)}, nil
+}
+
+func (s *streamStubClient) PauseStreamWithResponse(_ context.Context, _ string, _ ...streams.RequestEditorFn) (*streams.PauseStreamResponse, error) {
+	s.pauseCalls++
+	return &streams.PauseStreamResponse{HTTPResponse: testStubResponse(http.StatusOK), Body: []byte(`{}`)}, nil
+}
+
+func (s *streamStubClient) ActivateStreamWithResponse(_ context.Context, _ string, _ ...streams.RequestEditorFn) (*streams.ActivateStreamResponse, error) {
+	status := s.activateStatus
+	if status == 0 {
+		status = http.StatusOK
+	}
+	return &streams.ActivateStreamResponse{HTTPResponse: testStubResponse(status), Body: []byte(`{}`)}, nil
+}
+
+func (s *streamStubClient) RemoveWithResponse(ctx context.Context, _ string, _ ...streams.RequestEditorFn) (*streams.RemoveResponse, error) {
+	s.removeCalls++
+	if s.removeBlocks {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return &streams.RemoveResponse{HTTPResponse: testStubResponse(http.StatusOK), Body: []byte(`{}`)}, nil
+}
+
+func testStreamSchema(t *testing.T) schema.Schema {
+	t.Helper()
+
+	var resp fwresource.SchemaResponse
+	(&StreamResource{}).Schema(context.Background(), fwresource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("building schema: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+func testStreamPlan(t *testing.T, data StreamResourceModel) tfsdk.Plan {
+	t.Helper()
+
+	s := testStreamSchema(t)
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)}
+	if diags := plan.Set(context.Background(), &data); diags.HasError() {
+		t.Fatalf("building plan: %v", diags)
+	}
+	return plan
+}
+
+func testStreamState(t *testing.T, data *StreamResourceModel) tfsdk.State {
+	t.Helper()
+
+	s := testStreamSchema(t)
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)}
+	if data != nil {
+		if diags := state.Set(context.Background(), data); diags.HasError() {
+			t.Fatalf("building state: %v", diags)
+		}
+	}
+	return state
+}
+
+func testS3StreamModel(t *testing.T, attrs map[string]attr.Value) StreamResourceModel {
+	t.Helper()
+
+	base := map[string]attr.Value{
+		"endpoint":           types.StringValue("s3.amazonaws.com"),
+		"access_key":         types.StringValue("access"),
+		"bucket":             types.StringValue("bucket"),
+		"object_prefix":      types.StringValue("streams/"),
+		"file_compression":   types.StringValue("gzip"),
+		"file_type":          types.StringValue(".json"),
+		"max_retry":          types.Int64Value(3),
+		"retry_interval_sec": types.Int64Value(1),
+		"use_ssl":            types.BoolValue(true),
+	}
+	for k, v := range attrs {
+		base[k] = v
+	}
+
+	data := testStreamModel(t, "s3", base)
+	data.Id = types.StringValue("stream-123")
+	data.Name = types.StringValue("test-stream")
+	data.Network = types.StringValue("ethereum-mainnet")
+	data.Dataset = types.StringValue("block")
+	data.StartRange = types.Int64Value(1)
+	data.DatasetBatchSize = types.Int64Value(1)
+	data.Status = types.StringValue("paused")
+	data.ElasticBatchEnabled = types.BoolValue(true)
+	data.Region = types.StringValue("usa_east")
+	return data
+}
+
+func TestStreamUpdate_PreservesOmittedSecrets(t *testing.T) {
+	// The API does not return secret_key.
+	stub := &streamStubClient{stream: testStreamAPIResponse()}
+	r := &StreamResource{client: stub}
+
+	state := testS3StreamModel(t, map[string]attr.Value{"secret_key": types.StringValue("existing-secret")})
+
+	for update, bucket := range []string{"bucket", "other-bucket"} {
+		// secret_key is omitted from the configuration, so it is planned as
+		// its prior state value.
+		planned := &planmodifier.StringResponse{}
+		preserveOmittedSecret{}.PlanModifyString(context.Background(), planmodifier.StringRequest{
+			ConfigValue: types.StringNull(),
+			StateValue:  destinationAttributeString(state, "secret_key"),
+		}, planned)
+		plan := testS3StreamModel(t, map[string]attr.Value{"bucket": types.StringValue(bucket), "secret_key": planned.PlanValue})
+		stub.stream["destination_attributes"].(map[string]interface{})["bucket"] = bucket
+
+		resp := fwresource.UpdateResponse{State: testStreamState(t, nil)}
+		r.Update(context.Background(), fwresource.UpdateRequest{
+			Plan:  testStreamPlan(t, plan),
+			State: testStreamState(t, &state),
+		}, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("update %d: unexpected diagnostics: %v", update, resp.Diagnostics)
+		}
+		if len(stub.updateBodies) != update+1 {
+			t.Fatalf("update %d: expected %d update calls, got %d", update, update+1, len(stub.updateBodies))
+		}
+
+		s3Attrs, err := stub.updateBodies[update].DestinationAttributes.AsS3Attributes()
+		if err != nil {
+			t.Fatalf("update %d: decoding destination_attributes: %v", update, err)
+		}
+		if s3Attrs.SecretKey != "existing-secret" {
+			t.Errorf("update %d: expected secret_key to be preserved from state, got %q", update, s3Attrs.SecretKey)
+		}
+		if s3Attrs.Bucket != bucket {
+			t.Errorf("update %d: expected bucket %q, got %q", update, bucket, s3Attrs.Bucket)
+		}
+
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+		if got := destinationAttributeString(state, "secret_key"); !got.Equal(types.StringValue("existing-secret")) {
+			t.Errorf("update %d: expected secret_key to be kept in state, got %v", update, got)
+		}
+	}
+}
+
+func TestPreserveOmittedSecret(t *testing.T) {
+	existing := testS3StreamModel(t, map[string]attr.Value{"secret_key": types.StringValue("existing-secret")})
+
+	for _, tc := range []struct {
+		name     string
+		config   StreamResourceModel
+		state    *StreamResourceModel
+		expected types.String
+	}{
+		{
+			name:     "configured",
+			config:   testS3StreamModel(t, map[string]attr.Value{"secret_key": types.StringValue("new-secret")}),
+			state:    &existing,
+			expected: types.StringValue("new-secret"),
+		},
+		{
+			name:     "omitted",
+			config:   testS3StreamModel(t, nil),
+			state:    &existing,
+			expected: types.StringValue("existing-secret"),
+		},
+		{
+			name:     "omitted on create",
+			config:   testS3StreamModel(t, nil),
+			expected: types.StringNull(),
+		},
+		{
+			name:     "moved to write-only",
+			config:   testS3StreamModel(t, map[string]attr.Value{"secret_key_wo": types.StringValue("new-secret")}),
+			state:    &existing,
+			expected: types.StringNull(),
+		},
+		{
+			name:     "destination changed",
+			config:   testStreamModel(t, "webhook", map[string]attr.Value{"url": types.StringValue("https://example.com/hook")}),
+			state:    &existing,
+			expected: types.StringNull(),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			configValue := destinationAttributeString(tc.config, "secret_key")
+			stateValue := types.StringNull()
+			if tc.state != nil {
+				stateValue = destinationAttributeString(*tc.state, "secret_key")
+			}
+
+			resp := &planmodifier.StringResponse{PlanValue: configValue}
+			if configValue.IsNull() {
+				resp.PlanValue = types.StringUnknown()
+			}
+			preserveOmittedSecret{}.PlanModifyString(context.Background(), planmodifier.StringRequest{
+				Path:        path.Root("destination_attributes").AtName("secret_key"),
+				Config:      testStreamConfig(t, tc.config),
+				State:       testStreamState(t, tc.state),
+				ConfigValue: configValue,
+				StateValue:  stateValue,
+				PlanValue:   resp.PlanValue,
+			}, resp)
+
+			if !resp.PlanValue.Equal(tc.expected) {
+				t.Errorf("expected plan %v, got %v", tc.expected, resp.PlanValue)
+			}
+		})
+	}
+}
+
+func testStreamAPIResponse() map[string]interface{} {
+	return map[string]interface{}{
+		"id":                    "stream-123",
+		"name":                  "test-stream",
+		"network":               "ethereum-mainnet",
+		"dataset":               "block",
+		"start_range":           1,
+		"end_range":             -1,
+		"dataset_batch_size":    1,
+		"destination":           "s3",
+		"status":                "paused",
+		"elastic_batch_enabled": true,
+		"region":                "usa_east",
+		"destination_attributes": map[string]interface{}{
+			"endpoint":           "s3.amazonaws.com",
+			"access_key":         "access",
+			"bucket":             "bucket",
+			"object_prefix":      "streams/",
+			"file_compression":   "gzip",
+			"file_type":          ".json",
+			"max_retry":          3,
+			"retry_interval_sec": 1,
+			"use_ssl":            true,
+		},
+	}
+}
+
+func TestStreamCreate_RetriesReadUntilVisible(t *testing.T) {
+	stub := &streamStubClient{
+		stream:          testStreamAPIResponse(),
+		findOneStatuses: []int{http.StatusNotFound},
+	}
+	r := &StreamResource{client: stub, readRetries: 2, readRetryInterval: time.Millisecond}
+
+	plan := testS3StreamModel(t, map[string]attr.Value{"secret_key": types.StringValue("secret")})
+	plan.Id = types.StringUnknown()
+
+	resp := fwresource.CreateResponse{State: testStreamState(t, nil)}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: testStreamPlan(t, plan)}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if stub.findOneCalls != 2 {
+		t.Errorf("expected 2 FindOne calls, got %d", stub.findOneCalls)
+	}
+
+	var state StreamResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if state.Id.ValueString() != "stream-123" {
+		t.Errorf("expected id stream-123 in state, got %q", state.Id.ValueString())
+	}
+}
+
+func TestStreamCreate_ReadNotRetriedByDefault(t *testing.T) {
+	stub := &streamStubClient{
+		stream:          testStreamAPIResponse(),
+		findOneStatuses: []int{http.StatusNotFound},
+	}
+	r := &StreamResource{client: stub}
+
+	plan := testS3StreamModel(t, nil)
+	plan.Id = types.StringUnknown()
+
+	resp := fwresource.CreateResponse{State: testStreamState(t, nil)}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: testStreamPlan(t, plan)}, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected an error when the created stream is not found")
+	}
+	if stub.findOneCalls != 1 {
+		t.Errorf("expected 1 FindOne call, got %d", stub.findOneCalls)
+	}
+}
+
+func testStreamConfig(t *testing.T, data StreamResourceModel) tfsdk.Config {
+	t.Helper()
+
+	plan := testStreamPlan(t, data)
+	return tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}
+}
+
+func runStreamModifyPlan(t *testing.T, r *StreamResource, config, plan StreamResourceModel) (StreamResourceModel, fwresource.ModifyPlanResponse) {
+	t.Helper()
+
+	resp := fwresource.ModifyPlanResponse{Plan: testStreamPlan(t, plan)}
+	r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{
+		Config: testStreamConfig(t, config),
+		Plan:   testStreamPlan(t, plan),
+		State:  testStreamState(t, nil),
+	}, &resp)
+
+	var got StreamResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(context.Background(), &got)...)
+	return got, resp
+}
+
+func TestStreamModifyPlan_DefaultDatasetBatchSize(t *testing.T) {
+	r := &StreamResource{defaultDatasetBatchSize: 10}
+
+	config := testS3StreamModel(t, nil)
+	config.Id = types.StringNull()
+	config.DatasetBatchSize = types.Int64Null()
+	plan := config
+	plan.Id = types.StringUnknown()
+	plan.DatasetBatchSize = types.Int64Unknown()
+
+	got, resp := runStreamModifyPlan(t, r, config, plan)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if got.DatasetBatchSize.ValueInt64() != 10 {
+		t.Errorf("expected inherited dataset_batch_size 10, got %v", got.DatasetBatchSize)
+	}
+}
+
+func TestStreamModifyPlan_ResourceDatasetBatchSizeWins(t *testing.T) {
+	r := &StreamResource{defaultDatasetBatchSize: 10}
+
+	config := testS3StreamModel(t, nil)
+	config.Id = types.StringNull()
+	config.DatasetBatchSize = types.Int64Value(5)
+	plan := config
+	plan.Id = types.StringUnknown()
+
+	got, resp := runStreamModifyPlan(t, r, config, plan)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if got.DatasetBatchSize.ValueInt64() != 5 {
+		t.Errorf("expected resource dataset_batch_size 5, got %v", got.DatasetBatchSize)
+	}
+}
+
+func TestStreamModifyPlan_DefaultElasticBatchByDataset(t *testing.T) {
+	r := &StreamResource{defaultElasticBatchByDataset: map[string]bool{"block": false, "receipts": true}}
+
+	for dataset, want := range map[string]bool{"block": false, "receipts": true} {
+		config := testS3StreamModel(t, nil)
+		config.Id = types.StringNull()
+		config.Dataset = types.StringValue(dataset)
+		config.ElasticBatchEnabled = types.BoolNull()
+		plan := config
+		plan.Id = types.StringUnknown()
+		plan.ElasticBatchEnabled = types.BoolUnknown()
+
+		got, resp := runStreamModifyPlan(t, r, config, plan)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %v", dataset, resp.Diagnostics)
+		}
+		if !got.ElasticBatchEnabled.Equal(types.BoolValue(want)) {
+			t.Errorf("%s: expected inherited elastic_batch_enabled %t, got %v", dataset, want, got.ElasticBatchEnabled)
+		}
+	}
+}
+
+func TestStreamModifyPlan_ResourceElasticBatchEnabledWins(t *testing.T) {
+	r := &StreamResource{defaultElasticBatchByDataset: map[string]bool{"block": false}}
+
+	config := testS3StreamModel(t, nil)
+	config.Id = types.StringNull()
+	config.ElasticBatchEnabled = types.BoolValue(true)
+	plan := config
+	plan.Id = types.StringUnknown()
+
+	got, resp := runStreamModifyPlan(t, r, config, plan)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !got.ElasticBatchEnabled.ValueBool() {
+		t.Errorf("expected resource elastic_batch_enabled true, got %v", got.ElasticBatchEnabled)
+	}
+}
+
+func TestStreamModifyPlan_MissingElasticBatchEnabled(t *testing.T) {
+	r := &StreamResource{defaultElasticBatchByDataset: map[string]bool{"receipts": true}}
+
+	config := testS3StreamModel(t, nil)
+	config.Id = types.StringNull()
+	config.ElasticBatchEnabled = types.BoolNull()
+	plan := config
+	plan.Id = types.StringUnknown()
+	plan.ElasticBatchEnabled = types.BoolUnknown()
+
+	_, resp := runStreamModifyPlan(t, r, config, plan)
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected an error when neither the stream nor the provider default for its dataset sets elastic_batch_enabled")
+	}
+}
+
+func TestStreamModifyPlan_MissingDatasetBatchSize(t *testing.T) {
+	r := &StreamResource{}
+
+	config := testS3StreamModel(t, nil)
+	config.Id = types.StringNull()
+	config.DatasetBatchSize = types.Int64Null()
+	plan := config
+	plan.Id = types.StringUnknown()
+	plan.DatasetBatchSize = types.Int64Unknown()
+
+	_, resp := runStreamModifyPlan(t, r, config, plan)
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected an error when neither the stream nor the provider sets dataset_batch_size")
+	}
+}
+
+// planDestinationAttribute runs the schema plan modifiers of a computed
+// destination_attributes string field the way Terraform does when it is
+// omitted from config.
+func planDestinationAttribute(t *testing.T, name string, state types.String) types.String {
+	t.Helper()
+
+	destAttrs := testStreamSchema(t).Attributes["destination_attributes"].(schema.SingleNestedAttribute)
+	attribute := destAttrs.Attributes[name].(schema.StringAttribute)
+
+	resp := &planmodifier.StringResponse{PlanValue: types.StringUnknown()}
+	for _, modifier := range attribute.PlanModifiers {
+		modifier.PlanModifyString(context.Background(), planmodifier.StringRequest{
+			Path:        path.Root("destination_attributes").AtName(name),
+			ConfigValue: types.StringNull(),
+			StateValue:  state,
+			PlanValue:   resp.PlanValue,
+		}, resp)
+	}
+	return resp.PlanValue
+}
+
+func TestStreamSchema_ComputedDestinationAttributesStable(t *testing.T) {
+	for name, value := range map[string]string{
+		"security_token": "generated-by-quicknode-0123456789abcdef",
+		"version":        "1",
+	} {
+		t.Run(name, func(t *testing.T) {
+			state := types.StringValue(value)
+			for i := 0; i < 2; i++ {
+				state = planDestinationAttribute(t, name, state)
+				if !state.Equal(types.StringValue(value)) {
+					t.Fatalf("plan %d: expected %s to stay %q, got %v", i+1, name, value, state)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckFilterFunction(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		encoded     string
+		expectError string
+	}{
+		{
+			name:    "valid filter",
+			encoded: base64.StdEncoding.EncodeToString([]byte("function main(stream) { return stream; }")),
+		},
+		{
+			name:        "not base64",
+			encoded:     "function main(stream) { return stream; }",
+			expectError: "must be base64 encoded",
+		},
+		{
+			name:        "decodes to invalid UTF-8",
+			encoded:     base64.StdEncoding.EncodeToString([]byte{0xff, 0xfe, 0xfd}),
+			expectError: "must decode to UTF-8",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkFilterFunction(tc.encoded)
+			if tc.expectError == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.expectError) {
+				t.Errorf("expected error containing %q, got %v", tc.expectError, err)
+			}
+		})
+	}
+}
+
+func TestStreamUpdate_InvalidFilterFunctionDoesNotPause(t *testing.T) {
+	stub := &streamStubClient{}
+	r := &StreamResource{client: stub}
+
+	state := testS3StreamModel(t, nil)
+	plan := state
+	plan.FilterFunction = types.StringValue("not base64!")
+
+	resp := &fwresource.UpdateResponse{State: testStreamState(t, &state)}
+	r.Update(context.Background(), fwresource.UpdateRequest{
+		Plan:  testStreamPlan(t, plan),
+		State: testStreamState(t, &state),
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error diagnostics for invalid filter_function")
+	}
+	if got := resp.Diagnostics.Errors()[0].Summary(); got != "Invalid filter_function" {
+		t.Errorf("expected summary 'Invalid filter_function', got %q", got)
+	}
+	if stub.pauseCalls != 0 || len(stub.updateBodies) != 0 {
+		t.Errorf("expected no pause or update calls, got %d and %d", stub.pauseCalls, len(stub.updateBodies))
+	}
+}
+
+func TestStreamDelete_WaitsUntilGone(t *testing.T) {
+	stub := &streamStubClient{
+		stream:          testStreamAPIResponse(),
+		findOneStatuses: []int{http.StatusOK, http.StatusNotFound},
+	}
+	r := &StreamResource{client: stub, deleteWaitTimeout: time.Second, deletePollInterval: time.Millisecond}
+
+	state := testS3StreamModel(t, nil)
+	resp := &fwresource.DeleteResponse{}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: testStreamState(t, &state)}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if stub.removeCalls != 1 {
+		t.Errorf("expected 1 Remove call, got %d", stub.removeCalls)
+	}
+	if stub.findOneCalls != 2 {
+		t.Errorf("expected 2 FindOne calls, got %d", stub.findOneCalls)
+	}
+}
+
+func TestStreamDelete_WaitTimesOut(t *testing.T) {
+	stub := &streamStubClient{stream: testStreamAPIResponse()}
+	r := &StreamResource{client: stub, deleteWaitTimeout: 5 * time.Millisecond, deletePollInterval: time.Millisecond}
+
+	state := testS3StreamModel(t, nil)
+	resp := &fwresource.DeleteResponse{}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: testStreamState(t, &state)}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error diagnostics when the stream is never reported gone")
+	}
+	if got := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(got, "still present") {
+		t.Errorf("expected detail mentioning the stream is still present, got %q", got)
+	}
+}
+
+func TestStreamDelete_NoWaitByDefault(t *testing.T) {
+	stub := &streamStubClient{}
+	r := &StreamResource{client: stub}
+
+	state := testS3StreamModel(t, nil)
+	resp := &fwresource.DeleteResponse{}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: testStreamState(t, &state)}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if stub.findOneCalls != 0 {
+		t.Errorf("expected no FindOne calls, got %d", stub.findOneCalls)
+	}
+}
+
+func TestStreamDelete_TimesOut(t *testing.T) {
+	stub := &streamStubClient{removeBlocks: true}
+	r := &StreamResource{client: stub, deleteTimeout: 10 * time.Millisecond}
+
+	state := testS3StreamModel(t, nil)
+	resp := &fwresource.DeleteResponse{}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: testStreamState(t, &state)}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error diagnostics for a delete that never completes")
+	}
+	if got := resp.Diagnostics.Errors()[0].Summary(); got != "Stream Deletion Timed Out" {
+		t.Errorf("expected summary 'Stream Deletion Timed Out', got %q", got)
+	}
+}
+
+func TestStreamDelete_Canceled(t *testing.T) {
+	stub := &streamStubClient{removeBlocks: true}
+	r := &StreamResource{client: stub, deleteTimeout: time.Minute}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	state := testS3StreamModel(t, nil)
+	resp := &fwresource.DeleteResponse{}
+	r.Delete(ctx, fwresource.DeleteRequest{State: testStreamState(t, &state)}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error diagnostics for a canceled delete")
+	}
+	if got := resp.Diagnostics.Errors()[0].Summary(); got != "Stream Deletion Canceled" {
+		t.Errorf("expected summary 'Stream Deletion Canceled', got %q", got)
+	}
+}
+
+func TestStreamImport_OnlyDestinationAttributes(t *testing.T) {
+	// Every field the API might return for any destination; each import
+	// should keep only those belonging to the stream's destination.
+	apiAttrs := map[string]interface{}{
+		"url":                "https://example.com/hook",
+		"compression":        "none",
+		"headers":            map[string]interface{}{},
+		"post_timeout_sec":   30,
+		"security_token":     "generated-by-quicknode-0123456789abcdef",
+		"endpoint":           "s3.amazonaws.com",
+		"access_key":         "access",
+		"bucket":             "bucket",
+		"object_prefix":      "streams/",
+		"file_compression":   "gzip",
+		"file_type":          ".json",
+		"use_ssl":            true,
+		"username":           "user",
+		"host":               "db.example.com",
+		"port":               5432,
+		"database":           "streams",
+		"sslmode":            "require",
+		"table_name":         "blocks",
+		"max_retry":          3,
+		"retry_interval_sec": 1,
+	}
+
+	for destination, keys := range destinationAttributeKeys {
+		t.Run(destination, func(t *testing.T) {
+			stream := testStreamAPIResponse()
+			stream["destination"] = destination
+			stream["destination_attributes"] = apiAttrs
+			r := &StreamResource{client: &streamStubClient{stream: stream}}
+
+			importResp := &fwresource.ImportStateResponse{State: testStreamState(t, nil)}
+			r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: "stream-123"}, importResp)
+			if importResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected import diagnostics: %v", importResp.Diagnostics)
+			}
+
+			readResp := &fwresource.ReadResponse{State: importResp.State}
+			r.Read(context.Background(), fwresource.ReadRequest{State: importResp.State}, readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+			}
+
+			var state StreamResourceModel
+			readResp.Diagnostics.Append(readResp.State.Get(context.Background(), &state)...)
+			if state.Destination.ValueString() != destination {
+				t.Fatalf("expected destination %q, got %q", destination, state.Destination.ValueString())
+			}
+
+			for name, value := range state.DestinationAttributes.Attributes() {
+				_, returned := apiAttrs[name]
+				if relevant := slices.Contains(keys, name); relevant && returned && value.IsNull() {
+					t.Errorf("expected %s to be imported for %s, got null", name, destination)
+				} else if !relevant && !value.IsNull() {
+					t.Errorf("expected %s to be null for %s, got %v", name, destination, value)
+				}
+			}
+		})
+	}
+}
+
+func TestStreamCreate_WriteOnlyCredentialsNotInState(t *testing.T) {
+	stream := testStreamAPIResponse()
+	stream["destination_attributes"].(map[string]interface{})["secret_key"] = "write-only-secret"
+	stub := &streamStubClient{stream: stream}
+	r := &StreamResource{client: stub}
+
+	config := testS3StreamModel(t, map[string]attr.Value{
+		"secret_key_wo":          types.StringValue("write-only-secret"),
+		"credentials_wo_version": types.Int64Value(1),
+	})
+	// Terraform never puts write-only values in the plan.
+	plan := testS3StreamModel(t, map[string]attr.Value{"credentials_wo_version": types.Int64Value(1)})
+	plan.Id = types.StringUnknown()
+
+	resp := fwresource.CreateResponse{State: testStreamState(t, nil)}
+	r.Create(context.Background(), fwresource.CreateRequest{
+		Config: testStreamConfig(t, config),
+		Plan:   testStreamPlan(t, plan),
+	}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	s3Attrs, err := stub.createBodies[0].DestinationAttributes.AsS3Attributes()
+	if err != nil {
+		t.Fatalf("decoding destination_attributes: %v", err)
+	}
+	if s3Attrs.SecretKey != "write-only-secret" {
+		t.Errorf("expected the write-only secret to be sent, got %q", s3Attrs.SecretKey)
+	}
+
+	var state StreamResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	attrs := state.DestinationAttributes.Attributes()
+	for _, name := range []string{"secret_key", "secret_key_wo"} {
+		if !attrs[name].IsNull() {
+			t.Errorf("expected %s to be absent from state, got %v", name, attrs[name])
+		}
+	}
+	if !attrs["credentials_wo_version"].Equal(types.Int64Value(1)) {
+		t.Errorf("expected credentials_wo_version to be kept, got %v", attrs["credentials_wo_version"])
+	}
+
+	// A refresh must not bring the secret back into state either.
+	readResp := &fwresource.ReadResponse{State: resp.State}
+	r.Read(context.Background(), fwresource.ReadRequest{State: resp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+	readResp.Diagnostics.Append(readResp.State.Get(context.Background(), &state)...)
+	if got := state.DestinationAttributes.Attributes()["secret_key"]; !got.IsNull() {
+		t.Errorf("expected secret_key to stay out of state after read, got %v", got)
+	}
+}
+
+func TestStreamCreate_S3EndpointURL(t *testing.T) {
+	stream := testStreamAPIResponse()
+	stream["destination_attributes"].(map[string]interface{})["endpoint"] = "account.r2.cloudflarestorage.com"
+	stub := &streamStubClient{stream: stream}
+	r := &StreamResource{client: stub}
+
+	plan := testS3StreamModel(t, map[string]attr.Value{
+		"endpoint": types.StringValue("https://account.r2.cloudflarestorage.com"),
+	})
+	plan.Id = types.StringUnknown()
+
+	resp := fwresource.CreateResponse{State: testStreamState(t, nil)}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: testStreamPlan(t, plan)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	s3Attrs, err := stub.createBodies[0].DestinationAttributes.AsS3Attributes()
+	if err != nil {
+		t.Fatalf("decoding destination_attributes: %v", err)
+	}
+	if s3Attrs.Endpoint != "account.r2.cloudflarestorage.com" {
+		t.Errorf("expected the endpoint host to be sent, got %q", s3Attrs.Endpoint)
+	}
+
+	var state StreamResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if got := state.DestinationAttributes.Attributes()["endpoint"]; !got.Equal(types.StringValue("https://account.r2.cloudflarestorage.com")) {
+		t.Errorf("expected the configured endpoint URL in state, got %v", got)
+	}
+}
+
+func TestStreamCreate_WebhookHeaderCasing(t *testing.T) {
+	stream := testStreamAPIResponse()
+	stream["destination"] = "webhook"
+	stream["destination_attributes"] = map[string]interface{}{
+		"url":                "https://example.com/hook",
+		"compression":        "none",
+		"headers":            map[string]interface{}{"Content-Type": "application/json", "X-Api-Version": "2"},
+		"max_retry":          3,
+		"retry_interval_sec": 1,
+		"post_timeout_sec":   30,
+		"security_token":     "generated-by-quicknode-0123456789abcdef",
+	}
+	stub := &streamStubClient{stream: stream}
+	r := &StreamResource{client: stub}
+
+	configured := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"content-type":  types.StringValue("application/json"),
+		"x-api-version": types.StringValue("2"),
+	})
+	plan := testS3StreamModel(t, nil)
+	webhook := testStreamModel(t, "webhook", map[string]attr.Value{
+		"url":                types.StringValue("https://example.com/hook"),
+		"compression":        types.StringValue("none"),
+		"headers":            configured,
+		"max_retry":          types.Int64Value(3),
+		"retry_interval_sec": types.Int64Value(1),
+		"post_timeout_sec":   types.Int64Value(30),
+		"security_token":     types.StringUnknown(),
+	})
+	plan.Destination = webhook.Destination
+	plan.DestinationAttributes = webhook.DestinationAttributes
+	plan.Id = types.StringUnknown()
+
+	resp := fwresource.CreateResponse{State: testStreamState(t, nil)}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: testStreamPlan(t, plan)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	webhookAttrs, err := stub.createBodies[0].DestinationAttributes.AsWebhookAttributes()
+	if err != nil {
+		t.Fatalf("decoding destination_attributes: %v", err)
+	}
+	for _, name := range []string{"Content-Type", "X-Api-Version"} {
+		if _, ok := webhookAttrs.Headers[name]; !ok {
+			t.Errorf("expected header %s to be sent canonicalized, got %v", name, webhookAttrs.Headers)
+		}
+	}
+
+	var state StreamResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if got := state.DestinationAttributes.Attributes()["headers"]; !got.Equal(configured) {
+		t.Errorf("expected the configured header names in state, got %v", got)
+	}
+}
+
+func TestCanonicalHeaders_Duplicate(t *testing.T) {
+	_, err := canonicalHeaders(map[string]interface{}{"content-type": "a", "Content-Type": "b"})
+	if err == nil {
+		t.Fatalf("expected an error for headers differing only in case")
+	}
+}
+
+func TestCheckRangePrecision(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		startRange    types.Int64
+		endRange      types.Int64
+		expectWarning int
+	}{
+		{name: "small ranges", startRange: types.Int64Value(1000), endRange: types.Int64Value(16_777_216)},
+		{name: "unset ranges", startRange: types.Int64Null(), endRange: types.Int64Unknown()},
+		{name: "large start_range", startRange: types.Int64Value(21_000_001), endRange: types.Int64Null(), expectWarning: 1},
+		{name: "both large", startRange: types.Int64Value(21_000_001), endRange: types.Int64Value(21_000_100), expectWarning: 2},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := checkRangePrecision(StreamResourceModel{StartRange: tc.startRange, EndRange: tc.endRange})
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+			if got := diags.WarningsCount(); got != tc.expectWarning {
+				t.Errorf("expected %d warnings, got %d: %v", tc.expectWarning, got, diags)
+			}
+		})
+	}
+}
+
+// testKafkaStreamModel builds a kafka stream configured through
+// destination_attributes_json, a destination the typed helpers do not support.
+func testKafkaStreamModel(t *testing.T, attrsJson string) StreamResourceModel {
+	t.Helper()
+
+	data := testS3StreamModel(t, nil)
+	data.Destination = types.StringValue("kafka")
+	data.DestinationAttributes = types.ObjectNull(destinationAttributesType)
+	data.DestinationAttributesJson = types.StringValue(attrsJson)
+	return data
+}
+
+func TestStreamCreate_DestinationAttributesJson(t *testing.T) {
+	stream := testStreamAPIResponse()
+	stream["destination"] = "kafka"
+	stream["destination_attributes"] = map[string]interface{}{
+		"brokers":   "broker-1:9092",
+		"topic":     "blocks",
+		"max_retry": 3,
+	}
+	stub := &streamStubClient{stream: stream}
+	r := &StreamResource{client: stub}
+
+	attrsJson := `{"brokers":"broker-1:9092","topic":"blocks"}`
+	plan := testKafkaStreamModel(t, attrsJson)
+	plan.Id = types.StringUnknown()
+
+	resp := fwresource.CreateResponse{State: testStreamState(t, nil)}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: testStreamPlan(t, plan)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	sent, err := stub.createBodies[0].DestinationAttributes.MarshalJSON()
+	if err != nil {
+		t.Fatalf("encoding destination_attributes: %v", err)
+	}
+	if string(sent) != attrsJson {
+		t.Errorf("expected destination_attributes_json to be sent as is, got %s", sent)
+	}
+
+	var state StreamResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if !state.DestinationAttributes.IsNull() {
+		t.Errorf("expected destination_attributes to stay null, got %v", state.DestinationAttributes)
+	}
+	if !state.DestinationAttributesJson.Equal(types.StringValue(attrsJson)) {
+		t.Errorf("expected destination_attributes_json to be kept as configured, got %v", state.DestinationAttributesJson)
+	}
+}
+
+func TestStreamUpdate_DestinationAttributesJson(t *testing.T) {
+	stream := testStreamAPIResponse()
+	stream["destination"] = "kafka"
+	stub := &streamStubClient{stream: stream}
+	r := &StreamResource{client: stub}
+
+	state := testKafkaStreamModel(t, `{"brokers":"broker-1:9092","topic":"blocks"}`)
+	plan := testKafkaStreamModel(t, `{"brokers":"broker-1:9092","topic":"receipts"}`)
+
+	resp := fwresource.UpdateResponse{State: testStreamState(t, nil)}
+	r.Update(context.Background(), fwresource.UpdateRequest{
+		Plan:  testStreamPlan(t, plan),
+		State: testStreamState(t, &state),
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	sent, err := stub.updateBodies[0].DestinationAttributes.MarshalJSON()
+	if err != nil {
+		t.Fatalf("encoding destination_attributes: %v", err)
+	}
+	if string(sent) != `{"brokers":"broker-1:9092","topic":"receipts"}` {
+		t.Errorf("expected the planned destination_attributes_json to be sent, got %s", sent)
+	}
+}
+
+func TestStreamUpdate_FailedReactivationRecordsPaused(t *testing.T) {
+	stream := testStreamAPIResponse()
+	stream["status"] = "active"
+	stub := &streamStubClient{stream: stream, activateStatus: http.StatusInternalServerError}
+	r := &StreamResource{client: stub}
+
+	state := testS3StreamModel(t, nil)
+	state.Status = types.StringValue("active")
+	plan := testS3StreamModel(t, map[string]attr.Value{"bucket": types.StringValue("new-bucket")})
+	plan.Status = types.StringValue("active")
+
+	resp := fwresource.UpdateResponse{State: testStreamState(t, nil)}
+	r.Update(context.Background(), fwresource.UpdateRequest{
+		Plan:  testStreamPlan(t, plan),
+		State: testStreamState(t, &state),
+	}, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error diagnostics for a failed reactivation")
+	}
+	if len(stub.updateBodies) != 1 {
+		t.Fatalf("expected 1 update call, got %d", len(stub.updateBodies))
+	}
+
+	var got StreamResourceModel
+	resp.State.Get(context.Background(), &got)
+	if !got.Status.Equal(types.StringValue("paused")) {
+		t.Errorf("expected state to record the stream as paused, got %v", got.Status)
+	}
+}
+
+func TestStreamCreate_MixedCaseNetwork(t *testing.T) {
+	stub := &streamStubClient{stream: testStreamAPIResponse()}
+	r := &StreamResource{client: stub}
+
+	plan := testS3StreamModel(t, map[string]attr.Value{"secret_key": types.StringValue("secret")})
+	plan.Id = types.StringUnknown()
+	plan.Network = types.StringValue("Ethereum-Mainnet")
+
+	resp := fwresource.CreateResponse{State: testStreamState(t, nil)}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: testStreamPlan(t, plan)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if got := string(stub.createBodies[0].Network); got != "ethereum-mainnet" {
+		t.Errorf("expected network to be sent as ethereum-mainnet, got %q", got)
+	}
+
+	var state StreamResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if got := state.Network.ValueString(); got != "Ethereum-Mainnet" {
+		t.Errorf("expected configured network to be kept in state, got %q", got)
+	}
+}
+
+func TestRequiresReplaceIfNetworkChanged(t *testing.T) {
+	for _, tc := range []struct {
+		state, plan string
+		expected    bool
+	}{
+		{"ethereum-mainnet", "Ethereum-Mainnet", false},
+		{"ethereum-mainnet", "ethereum-sepolia", true},
+	} {
+		resp := &stringplanmodifier.RequiresReplaceIfFuncResponse{}
+		requiresReplaceIfNetworkChanged(context.Background(), planmodifier.StringRequest{
+			StateValue: types.StringValue(tc.state),
+			PlanValue:  types.StringValue(tc.plan),
+		}, resp)
+
+		if resp.RequiresReplace != tc.expected {
+			t.Errorf("%s -> %s: expected RequiresReplace %v, got %v", tc.state, tc.plan, tc.expected, resp.RequiresReplace)
+		}
+	}
+}
+
+func TestReadStreamFromAPI_DataEnvelope(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		stream map[string]interface{}
+	}{
+		{"bare object", testStreamAPIResponse()},
+		{"data envelope", map[string]interface{}{"data": testStreamAPIResponse(), "error": nil}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &StreamResource{client: &streamStubClient{stream: tc.stream}}
+
+			data, err := r.readStreamFromAPI(context.Background(), "stream-123")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := data.Id.ValueString(); got != "stream-123" {
+				t.Errorf("expected id stream-123, got %q", got)
+			}
+			if got := data.Network.ValueString(); got != "ethereum-mainnet" {
+				t.Errorf("expected network ethereum-mainnet, got %q", got)
+			}
+			if got := data.Destination.ValueString(); got != "s3" {
+				t.Errorf("expected destination s3, got %q", got)
+			}
+		})
+	}
+}
+
+func TestReadStreamFromAPI_EndRange(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		endRange float64
+		prior    types.Int64
+		expected types.Int64
+	}{
+		{name: "bounded", endRange: 20000000, prior: types.Int64Null(), expected: types.Int64Value(20000000)},
+		{name: "minus one", endRange: -1, prior: types.Int64Null(), expected: types.Int64Null()},
+		{name: "other negative", endRange: -2, prior: types.Int64Null(), expected: types.Int64Null()},
+		{name: "max int64", endRange: math.MaxInt64, prior: types.Int64Null(), expected: types.Int64Null()},
+		{name: "max safe integer", endRange: 1<<53 - 1, prior: types.Int64Null(), expected: types.Int64Null()},
+		{name: "max int32", endRange: math.MaxInt32, prior: types.Int64Null(), expected: types.Int64Null()},
+		{name: "max uint32 after another end", endRange: math.MaxUint32, prior: types.Int64Value(20000000), expected: types.Int64Null()},
+		{name: "max int32 as configured", endRange: math.MaxInt32, prior: types.Int64Value(math.MaxInt32), expected: types.Int64Value(math.MaxInt32)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			stream := testStreamAPIResponse()
+			stream["end_range"] = tc.endRange
+			r := &StreamResource{client: &streamStubClient{stream: stream}}
+
+			data, err := r.readStreamFromAPI(context.Background(), "stream-123", &StreamResourceModel{EndRange: tc.prior})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !data.EndRange.Equal(tc.expected) {
+				t.Errorf("expected end_range %v, got %v", tc.expected, data.EndRange)
+			}
+		})
+	}
+}
+
+func TestConvertDestinationAttributes_Nested(t *testing.T) {
+	brokers := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("broker-1:9092"), types.StringValue("broker-2:9092")})
+	sasl := types.ObjectValueMust(map[string]attr.Type{"mechanism": types.StringType}, map[string]attr.Value{"mechanism": types.StringValue("PLAIN")})
+	attrs := types.ObjectValueMust(
+		map[string]attr.Type{"brokers": brokers.Type(context.Background()), "sasl": sasl.Type(context.Background())},
+		map[string]attr.Value{"brokers": brokers, "sasl": sasl},
+	)
+
+	destAttrs, err := convertDestinationAttributes(attrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	body, _ := json.Marshal(destAttrs)
+	if expected := `{"brokers":["broker-1:9092","broker-2:9092"],"sasl":{"mechanism":"PLAIN"}}`; string(body) != expected {
+		t.Errorf("expected %s, got %s", expected, body)
+	}
+}
+
+func TestConvertDestinationAttributes_UnsupportedType(t *testing.T) {
+	number := types.NumberValue(big.NewFloat(1))
+	headers := types.MapValueMust(types.NumberType, map[string]attr.Value{"X-Retries": number})
+	attrs := types.ObjectValueMust(
+		map[string]attr.Type{"headers": headers.Type(context.Background())},
+		map[string]attr.Value{"headers": headers},
+	)
+
+	_, err := convertDestinationAttributes(attrs)
+	if err == nil {
+		t.Fatalf("expected an error for a number value")
+	}
+	if expected := `destination_attributes.headers["X-Retries"] has unsupported value type basetypes.NumberValue`; err.Error() != expected {
+		t.Errorf("expected error %q, got %q", expected, err.Error())
+	}
+}
+
+func TestStreamSchema_ReplaceTriggers(t *testing.T) {
+	attribute := testStreamSchema(t).Attributes["replace_triggers"].(schema.MapAttribute)
+	triggers := func(version string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{"filter_version": types.StringValue(version)})
+	}
+
+	for _, tc := range []struct {
+		name     string
+		state    types.Map
+		plan     types.Map
+		expected bool
+	}{
+		{"unchanged", triggers("1"), triggers("1"), false},
+		{"changed", triggers("1"), triggers("2"), true},
+		{"added", types.MapNull(types.StringType), triggers("1"), true},
+		{"removed", triggers("1"), types.MapNull(types.StringType), true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			state := testS3StreamModel(t, nil)
+			state.ReplaceTriggers = tc.state
+			plan := state
+			plan.ReplaceTriggers = tc.plan
+
+			resp := &planmodifier.MapResponse{PlanValue: tc.plan}
+			for _, modifier := range attribute.PlanModifiers {
+				modifier.PlanModifyMap(context.Background(), planmodifier.MapRequest{
+					Path:        path.Root("replace_triggers"),
+					Config:      testStreamConfig(t, plan),
+					ConfigValue: tc.plan,
+					Plan:        testStreamPlan(t, plan),
+					PlanValue:   tc.plan,
+					State:       testStreamState(t, &state),
+					StateValue:  tc.state,
+				}, resp)
+			}
+
+			if resp.RequiresReplace != tc.expected {
+				t.Errorf("expected RequiresReplace %t, got %t", tc.expected, resp.RequiresReplace)
+			}
+		})
+	}
+}
+
+func TestStreamModifyPlan_UnvalidatedNetwork(t *testing.T) {
+	config := testS3StreamModel(t, nil)
+	config.Id = types.StringNull()
+	config.Network = types.StringValue("newchain-testnet")
+	plan := config
+	plan.Id = types.StringUnknown()
+
+	_, resp := runStreamModifyPlan(t, &StreamResource{}, config, plan)
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected an error for an unknown network without allow_unvalidated_network")
+	}
+
+	_, resp = runStreamModifyPlan(t, &StreamResource{allowUnvalidatedNetwork: true}, config, plan)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("expected a warning that the network is not validated, got %v", resp.Diagnostics)
+	}
+}
+
+func TestStreamCreate_UnvalidatedNetwork(t *testing.T) {
+	stream := testStreamAPIResponse()
+	stream["network"] = "newchain-testnet"
+	stub := &streamStubClient{stream: stream}
+	r := &StreamResource{client: stub, allowUnvalidatedNetwork: true}
+
+	plan := testS3StreamModel(t, nil)
+	plan.Id = types.StringUnknown()
+	plan.Network = types.StringValue("newchain-testnet")
+
+	resp := fwresource.CreateResponse{State: testStreamState(t, nil)}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: testStreamPlan(t, plan)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if got := stub.createBodies[0].Network; got != "newchain-testnet" {
+		t.Errorf("expected network newchain-testnet to be sent to the API, got %q", got)
+	}
+}
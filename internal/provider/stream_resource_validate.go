@@ -0,0 +1,516 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/circlefin/terraform-provider-quicknode/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// streamConfigValidators are the cross-attribute rules run against a stream
+// configuration. Attribute-level checks belong in the schema validators; only
+// rules that need to look at more than one attribute live here.
+var streamConfigValidators = []func(StreamResourceModel) diag.Diagnostics{
+	validateStreamCompression,
+	validateStreamCompletedStatus,
+	validateStreamActiveEndRange,
+	validateStreamWriteOnlyCredentials,
+	validateStreamS3EndpointScheme,
+	validateStreamS3ObjectPrefix,
+	validateStreamPostgresAccessKey,
+	validateStreamWebhookRetryInterval,
+	validateStreamWebhookContentEncoding,
+	validateStreamKeepDistanceFromTip,
+	validateStreamDestinationAttributesJson,
+	validateStreamDestinationAttributeFields,
+}
+
+// destinationCompression describes the destination_attributes field that
+// controls compression for a destination and the values it accepts.
+type destinationCompression struct {
+	attribute string
+	values    []string
+}
+
+// destinationCompressions maps each destination to its compression field.
+// Destinations absent from the map do not support compression at all.
+var destinationCompressions = map[string]destinationCompression{
+	"webhook": {attribute: "compression", values: validators.WebhookCompressions},
+	"s3":      {attribute: "file_compression", values: validators.FileCompressions},
+}
+
+func (r *StreamResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data StreamResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, validate := range streamConfigValidators {
+		resp.Diagnostics.Append(validate(data)...)
+	}
+}
+
+// validateStreamCompression checks that only the compression field belonging
+// to the configured destination is set, and that its value is one the
+// destination accepts. While the destination is unknown, values are only
+// checked against those any destination accepts.
+func validateStreamCompression(data StreamResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Destination.IsNull() {
+		return diags
+	}
+
+	if data.Destination.IsUnknown() {
+		var allowed []string
+		for _, expected := range destinationCompressions {
+			for _, value := range expected.values {
+				if !slices.Contains(allowed, value) {
+					allowed = append(allowed, value)
+				}
+			}
+		}
+		slices.Sort(allowed)
+
+		for _, attribute := range []string{"compression", "file_compression"} {
+			value := destinationAttributeString(data, attribute)
+			if value.IsNull() || value.IsUnknown() || slices.Contains(allowed, value.ValueString()) {
+				continue
+			}
+
+			diags.AddAttributeError(
+				path.Root("destination_attributes").AtName(attribute),
+				"Invalid value",
+				fmt.Sprintf("Expected %s to be one of: %v, got: %s", attribute, allowed, value.ValueString()),
+			)
+		}
+		return diags
+	}
+
+	destination := data.Destination.ValueString()
+	expected, supported := destinationCompressions[destination]
+
+	for _, attribute := range []string{"compression", "file_compression"} {
+		value := destinationAttributeString(data, attribute)
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		attributePath := path.Root("destination_attributes").AtName(attribute)
+
+		if !supported {
+			diags.AddAttributeError(
+				attributePath,
+				"Invalid compression attribute",
+				fmt.Sprintf("The %s destination does not support compression, remove %s from destination_attributes", destination, attribute),
+			)
+			continue
+		}
+
+		if attribute != expected.attribute {
+			diags.AddAttributeError(
+				attributePath,
+				"Invalid compression attribute",
+				fmt.Sprintf("%s is not supported for the %s destination, use %s instead", attribute, destination, expected.attribute),
+			)
+			continue
+		}
+
+		if !slices.Contains(expected.values, value.ValueString()) {
+			diags.AddAttributeError(
+				attributePath,
+				"Invalid value",
+				fmt.Sprintf("Expected %s to be one of: %v for the %s destination, got: %s", attribute, expected.values, destination, value.ValueString()),
+			)
+		}
+	}
+
+	return diags
+}
+
+// validateStreamCompletedStatus rejects status = "completed". QuickNode sets
+// it once a stream reaches its end_range, and CreateStreamDto only accepts
+// active and paused, so it cannot be requested.
+func validateStreamCompletedStatus(data StreamResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Status.IsUnknown() || data.Status.ValueString() != "completed" {
+		return diags
+	}
+
+	detail := "completed is set by QuickNode once a stream reaches its end_range and cannot be requested, use active or paused"
+	if data.EndRange.IsNull() {
+		detail = "completed is set by QuickNode once a stream reaches its end_range, which this stream does not have, and cannot be requested, use active or paused"
+	}
+	diags.AddAttributeError(path.Root("status"), "Invalid status", detail)
+
+	return diags
+}
+
+// validateStreamActiveEndRange warns that an active stream with an end_range
+// before its start_range is already behind its own first block, so QuickNode
+// completes it as soon as it starts. Whether an end_range is behind the chain
+// tip cannot be told during validation.
+func validateStreamActiveEndRange(data StreamResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Status.IsUnknown() || data.Status.ValueString() != "active" {
+		return diags
+	}
+	if data.EndRange.IsNull() || data.EndRange.IsUnknown() || data.StartRange.IsNull() || data.StartRange.IsUnknown() {
+		return diags
+	}
+	if data.EndRange.ValueInt64() >= data.StartRange.ValueInt64() {
+		return diags
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("end_range"),
+		"Stream completes immediately",
+		fmt.Sprintf("end_range %d is before start_range %d, QuickNode sets the stream to completed as soon as it starts", data.EndRange.ValueInt64(), data.StartRange.ValueInt64()),
+	)
+
+	return diags
+}
+
+// validateStreamWriteOnlyCredentials checks a credential is not given both
+// directly and through its write-only attribute.
+func validateStreamWriteOnlyCredentials(data StreamResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for writeOnly, credential := range writeOnlyCredentials {
+		if destinationAttributeString(data, writeOnly).IsNull() || destinationAttributeString(data, credential).IsNull() {
+			continue
+		}
+
+		diags.AddAttributeError(
+			path.Root("destination_attributes").AtName(writeOnly),
+			"Conflicting credentials",
+			fmt.Sprintf("Only one of %s and %s can be set", credential, writeOnly),
+		)
+	}
+
+	return diags
+}
+
+// validateStreamS3EndpointScheme checks an S3 endpoint given as a URL agrees
+// with use_ssl, since only the host is sent to the Streams API.
+func validateStreamS3EndpointScheme(data StreamResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	endpoint := destinationAttributeString(data, "endpoint")
+	if data.Destination.ValueString() != "s3" || endpoint.IsNull() || endpoint.IsUnknown() {
+		return diags
+	}
+
+	_, scheme, err := validators.SplitS3Endpoint(endpoint.ValueString())
+	if err != nil || scheme == "" {
+		return diags
+	}
+
+	useSsl, _ := data.DestinationAttributes.Attributes()["use_ssl"].(types.Bool)
+	if useSsl.IsUnknown() {
+		return diags
+	}
+
+	if useSsl.IsNull() || useSsl.ValueBool() != (scheme == "https") {
+		diags.AddAttributeError(
+			path.Root("destination_attributes").AtName("use_ssl"),
+			"Conflicting use_ssl",
+			fmt.Sprintf("endpoint %s uses %s, set use_ssl = %t to match", endpoint.ValueString(), scheme, scheme == "https"),
+		)
+	}
+
+	return diags
+}
+
+// validateStreamS3ObjectPrefix warns when an S3 object_prefix does not end in
+// a slash, since objects are named by appending their file name to the prefix
+// and would not land in a folder of that name.
+func validateStreamS3ObjectPrefix(data StreamResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	prefix := destinationAttributeString(data, "object_prefix")
+	if data.Destination.ValueString() != "s3" || prefix.IsNull() || prefix.IsUnknown() || prefix.ValueString() == "" {
+		return diags
+	}
+
+	if !strings.HasSuffix(prefix.ValueString(), "/") {
+		diags.AddAttributeWarning(
+			path.Root("destination_attributes").AtName("object_prefix"),
+			"object_prefix is not a folder",
+			fmt.Sprintf("Objects are named by appending the file name to object_prefix, so %q produces names like %q. End the prefix with \"/\" to write objects into a folder.",
+				prefix.ValueString(), prefix.ValueString()+"<file name>"),
+		)
+	}
+
+	return diags
+}
+
+// validateStreamPostgresAccessKey checks the access_key of a postgres
+// destination is not empty, whether set directly or through access_key_wo.
+// That it is set at all is checked by checkPostgresAccessKey during plan,
+// since an omitted access_key may be kept from prior state.
+func validateStreamPostgresAccessKey(data StreamResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Destination.ValueString() != "postgres" || data.DestinationAttributes.IsNull() || data.DestinationAttributes.IsUnknown() {
+		return diags
+	}
+
+	accessKey := destinationAttributeString(data, "access_key")
+	accessKeyWo := destinationAttributeString(data, "access_key_wo")
+	if accessKey.IsUnknown() || accessKeyWo.IsUnknown() {
+		return diags
+	}
+
+	for attribute, value := range map[string]types.String{"access_key": accessKey, "access_key_wo": accessKeyWo} {
+		if !value.IsNull() && value.ValueString() == "" {
+			diags.AddAttributeError(
+				path.Root("destination_attributes").AtName(attribute),
+				"Invalid access_key",
+				fmt.Sprintf("%s must not be empty for the postgres destination", attribute),
+			)
+		}
+	}
+
+	return diags
+}
+
+// checkPostgresAccessKey checks a planned postgres destination has an
+// access_key, from the configuration, prior state or access_key_wo. The
+// Streams API requires it in PostgresAttributes, and getPostgresAttributes
+// would otherwise send an empty string.
+func checkPostgresAccessKey(config, plan StreamResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan.Destination.ValueString() != "postgres" || plan.DestinationAttributes.IsNull() || plan.DestinationAttributes.IsUnknown() {
+		return diags
+	}
+
+	accessKey := destinationAttributeString(plan, "access_key")
+	accessKeyWo := destinationAttributeString(config, "access_key_wo")
+	if !accessKey.IsNull() || !accessKeyWo.IsNull() {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("destination_attributes").AtName("access_key"),
+		"Missing access_key",
+		"The postgres destination requires access_key, set access_key or access_key_wo in destination_attributes",
+	)
+
+	return diags
+}
+
+// validateStreamWebhookRetryInterval checks a webhook destination does not wait
+// longer between retries than it allows each delivery to take.
+func validateStreamWebhookRetryInterval(data StreamResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Destination.ValueString() != "webhook" || data.DestinationAttributes.IsNull() || data.DestinationAttributes.IsUnknown() {
+		return diags
+	}
+
+	attrs := data.DestinationAttributes.Attributes()
+	retryInterval, _ := attrs["retry_interval_sec"].(types.Int64)
+	postTimeout, _ := attrs["post_timeout_sec"].(types.Int64)
+	if retryInterval.IsNull() || retryInterval.IsUnknown() || postTimeout.IsNull() || postTimeout.IsUnknown() {
+		return diags
+	}
+
+	if retryInterval.ValueInt64() > postTimeout.ValueInt64() {
+		diags.AddAttributeError(
+			path.Root("destination_attributes").AtName("retry_interval_sec"),
+			"Invalid retry_interval_sec",
+			fmt.Sprintf("Expected retry_interval_sec to be at most post_timeout_sec (%d) for the webhook destination, got: %d", postTimeout.ValueInt64(), retryInterval.ValueInt64()),
+		)
+	}
+
+	return diags
+}
+
+// validateStreamWebhookContentEncoding checks a Content-Encoding header given
+// in headers agrees with compression, since a receiver decodes each payload
+// by it. Without gzip compression only identity is accepted.
+func validateStreamWebhookContentEncoding(data StreamResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Destination.ValueString() != "webhook" || data.DestinationAttributes.IsNull() || data.DestinationAttributes.IsUnknown() {
+		return diags
+	}
+
+	compression := destinationAttributeString(data, "compression")
+	headers, _ := data.DestinationAttributes.Attributes()["headers"].(types.Map)
+	if compression.IsUnknown() || headers.IsNull() || headers.IsUnknown() {
+		return diags
+	}
+
+	configured := compression.ValueString()
+	if compression.IsNull() {
+		configured = "none"
+	}
+
+	expected := "identity"
+	if configured == "gzip" {
+		expected = "gzip"
+	}
+
+	for name, value := range headers.Elements() {
+		encoding, ok := value.(types.String)
+		if !ok || encoding.IsNull() || encoding.IsUnknown() || !strings.EqualFold(name, "Content-Encoding") {
+			continue
+		}
+
+		if !strings.EqualFold(strings.TrimSpace(encoding.ValueString()), expected) {
+			diags.AddAttributeError(
+				path.Root("destination_attributes").AtName("headers").AtMapKey(name),
+				"Conflicting Content-Encoding",
+				fmt.Sprintf("Expected the %s header to be %q for webhook compression %q, got: %q. Remove it or change compression.", name, expected, configured, encoding.ValueString()),
+			)
+		}
+	}
+
+	return diags
+}
+
+// validateStreamKeepDistanceFromTip checks keep_distance_from_tip against the
+// limit of the stream's network. It is counted in slots on Solana, where it
+// has no limit, and in blocks elsewhere.
+func validateStreamKeepDistanceFromTip(data StreamResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.KeepDistanceFromTip.IsNull() || data.KeepDistanceFromTip.IsUnknown() || data.Network.IsNull() || data.Network.IsUnknown() {
+		return diags
+	}
+
+	limit, ok := validators.KeepDistanceFromTipLimit(data.Network.ValueString())
+	if value := data.KeepDistanceFromTip.ValueInt64(); ok && value > limit {
+		diags.AddAttributeError(
+			path.Root("keep_distance_from_tip"),
+			"Invalid value",
+			fmt.Sprintf("Expected keep_distance_from_tip to be between 0 and %d on %s, got: %d", limit, data.Network.ValueString(), value),
+		)
+	}
+
+	return diags
+}
+
+// validateStreamDestinationAttributesJson checks exactly one form of the
+// destination attributes is set, and that destination_attributes_json holds a
+// JSON object.
+func validateStreamDestinationAttributesJson(data StreamResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.DestinationAttributes.IsUnknown() || data.DestinationAttributesJson.IsUnknown() {
+		return diags
+	}
+
+	switch {
+	case data.DestinationAttributes.IsNull() && data.DestinationAttributesJson.IsNull():
+		diags.AddAttributeError(
+			path.Root("destination_attributes"),
+			"Missing destination attributes",
+			"One of destination_attributes and destination_attributes_json must be set",
+		)
+		return diags
+	case !data.DestinationAttributes.IsNull() && !data.DestinationAttributesJson.IsNull():
+		diags.AddAttributeError(
+			path.Root("destination_attributes_json"),
+			"Conflicting destination attributes",
+			"Only one of destination_attributes and destination_attributes_json can be set",
+		)
+		return diags
+	case data.DestinationAttributesJson.IsNull():
+		return diags
+	}
+
+	var attrs map[string]interface{}
+	if err := json.Unmarshal([]byte(data.DestinationAttributesJson.ValueString()), &attrs); err != nil || attrs == nil {
+		diags.AddAttributeError(
+			path.Root("destination_attributes_json"),
+			"Invalid destination_attributes_json",
+			"destination_attributes_json must be a JSON object, use jsonencode to build it",
+		)
+	}
+
+	return diags
+}
+
+// validateStreamDestinationAttributeFields warns about destination_attributes
+// fields that do not belong to the configured destination. They are not sent
+// to the Streams API, so setting them has no effect. Compression fields are
+// left to validateStreamCompression.
+func validateStreamDestinationAttributeFields(data StreamResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Destination.IsUnknown() || data.DestinationAttributes.IsNull() || data.DestinationAttributes.IsUnknown() {
+		return diags
+	}
+
+	destination := data.Destination.ValueString()
+	keys, ok := destinationAttributeKeys[destination]
+	if !ok {
+		return diags
+	}
+
+	allowed := slices.Clone(keys)
+	for writeOnly, credential := range writeOnlyCredentials {
+		if slices.Contains(keys, credential) {
+			allowed = append(allowed, writeOnly, "credentials_wo_version")
+		}
+	}
+
+	for name, value := range data.DestinationAttributes.Attributes() {
+		if value.IsNull() || name == "compression" || name == "file_compression" || slices.Contains(allowed, name) {
+			continue
+		}
+
+		diags.AddAttributeWarning(
+			path.Root("destination_attributes").AtName(name),
+			"Unused destination attribute",
+			fmt.Sprintf("%s does not apply to the %s destination and is ignored, remove it from destination_attributes", name, destination),
+		)
+	}
+
+	return diags
+}
+
+// destinationAttributeString returns the named string field of
+// destination_attributes, or a null value when the object or field is unset.
+func destinationAttributeString(data StreamResourceModel, name string) types.String {
+	if data.DestinationAttributes.IsNull() || data.DestinationAttributes.IsUnknown() {
+		return types.StringNull()
+	}
+
+	if v, ok := data.DestinationAttributes.Attributes()[name].(types.String); ok {
+		return v
+	}
+
+	return types.StringNull()
+}
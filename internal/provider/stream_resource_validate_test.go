@@ -0,0 +1,508 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateStreamCompression(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		destination string
+		attrs       map[string]attr.Value
+		expectError string
+	}{
+		{
+			name:        "webhook with compression",
+			destination: "webhook",
+			attrs:       map[string]attr.Value{"compression": types.StringValue("gzip")},
+		},
+		{
+			name:        "s3 with file_compression",
+			destination: "s3",
+			attrs:       map[string]attr.Value{"file_compression": types.StringValue("none")},
+		},
+		{
+			name:        "no compression set",
+			destination: "postgres",
+		},
+		{
+			name:        "unknown compression is skipped",
+			destination: "webhook",
+			attrs:       map[string]attr.Value{"file_compression": types.StringUnknown()},
+		},
+		{
+			name:        "webhook with file_compression",
+			destination: "webhook",
+			attrs:       map[string]attr.Value{"file_compression": types.StringValue("gzip")},
+			expectError: "file_compression is not supported for the webhook destination, use compression instead",
+		},
+		{
+			name:        "s3 with compression",
+			destination: "s3",
+			attrs:       map[string]attr.Value{"compression": types.StringValue("gzip")},
+			expectError: "compression is not supported for the s3 destination, use file_compression instead",
+		},
+		{
+			name:        "postgres with compression",
+			destination: "postgres",
+			attrs:       map[string]attr.Value{"compression": types.StringValue("gzip")},
+			expectError: "The postgres destination does not support compression",
+		},
+		{
+			name:        "webhook with invalid value",
+			destination: "webhook",
+			attrs:       map[string]attr.Value{"compression": types.StringValue("zstd")},
+			expectError: "Expected compression to be one of",
+		},
+		{
+			name:        "webhook without compression",
+			destination: "webhook",
+			attrs:       map[string]attr.Value{"compression": types.StringValue("none")},
+		},
+		{
+			name:        "webhook with differently cased value",
+			destination: "webhook",
+			attrs:       map[string]attr.Value{"compression": types.StringValue("GZIP")},
+			expectError: "Expected compression to be one of: [none gzip] for the webhook destination, got: GZIP",
+		},
+		{
+			name:  "unknown destination with valid value",
+			attrs: map[string]attr.Value{"file_compression": types.StringValue("gzip")},
+		},
+		{
+			name:        "unknown destination with invalid value",
+			attrs:       map[string]attr.Value{"compression": types.StringValue("zstd")},
+			expectError: "Expected compression to be one of: [gzip none], got: zstd",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data := testStreamModel(t, tc.destination, tc.attrs)
+			if tc.destination == "" {
+				data.Destination = types.StringUnknown()
+			}
+			diags := validateStreamCompression(data)
+
+			if tc.expectError == "" {
+				if diags.HasError() {
+					t.Fatalf("expected no error diagnostics, got: %v", diags.Errors())
+				}
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatalf("expected error diagnostic containing %q", tc.expectError)
+			}
+			if got := diags.Errors()[0].Detail(); !strings.Contains(got, tc.expectError) {
+				t.Errorf("expected diagnostic detail containing %q, got %q", tc.expectError, got)
+			}
+		})
+	}
+}
+
+func TestValidateStreamActiveEndRange(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		status        types.String
+		startRange    types.Int64
+		endRange      types.Int64
+		expectWarning bool
+	}{
+		{name: "active without end_range", status: types.StringValue("active"), startRange: types.Int64Value(100), endRange: types.Int64Null()},
+		{name: "active with end_range ahead", status: types.StringValue("active"), startRange: types.Int64Value(100), endRange: types.Int64Value(200)},
+		{name: "active with single block", status: types.StringValue("active"), startRange: types.Int64Value(100), endRange: types.Int64Value(100)},
+		{name: "active with unknown end_range", status: types.StringValue("active"), startRange: types.Int64Value(100), endRange: types.Int64Unknown()},
+		{name: "active with unknown start_range", status: types.StringValue("active"), startRange: types.Int64Unknown(), endRange: types.Int64Value(50)},
+		{name: "paused with end_range behind", status: types.StringValue("paused"), startRange: types.Int64Value(100), endRange: types.Int64Value(50)},
+		{name: "active with end_range behind", status: types.StringValue("active"), startRange: types.Int64Value(100), endRange: types.Int64Value(50), expectWarning: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateStreamActiveEndRange(StreamResourceModel{Status: tc.status, StartRange: tc.startRange, EndRange: tc.endRange})
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+			if (diags.WarningsCount() > 0) != tc.expectWarning {
+				t.Errorf("expected warning %t, got diagnostics: %v", tc.expectWarning, diags)
+			}
+			for _, d := range diags.Warnings() {
+				if strings.Contains(d.Detail(), "completed\"") {
+					t.Errorf("warning should not advise setting status = completed, got %q", d.Detail())
+				}
+			}
+		})
+	}
+}
+
+func TestValidateStreamCompletedStatus(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		status      types.String
+		endRange    types.Int64
+		expectError bool
+	}{
+		{
+			name:     "active without end_range",
+			status:   types.StringValue("active"),
+			endRange: types.Int64Null(),
+		},
+		{
+			name:        "completed with end_range",
+			status:      types.StringValue("completed"),
+			endRange:    types.Int64Value(100),
+			expectError: true,
+		},
+		{
+			name:        "completed without end_range",
+			status:      types.StringValue("completed"),
+			endRange:    types.Int64Null(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateStreamCompletedStatus(StreamResourceModel{Status: tc.status, EndRange: tc.endRange})
+
+			if diags.HasError() != tc.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", tc.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestValidateStreamWriteOnlyCredentials(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		attrs       map[string]attr.Value
+		expectError bool
+	}{
+		{
+			name:  "plain credential",
+			attrs: map[string]attr.Value{"secret_key": types.StringValue("secret")},
+		},
+		{
+			name:  "write-only credential",
+			attrs: map[string]attr.Value{"secret_key_wo": types.StringValue("secret")},
+		},
+		{
+			name: "both set",
+			attrs: map[string]attr.Value{
+				"secret_key":    types.StringValue("secret"),
+				"secret_key_wo": types.StringValue("secret"),
+			},
+			expectError: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateStreamWriteOnlyCredentials(testStreamModel(t, "s3", tc.attrs))
+
+			if diags.HasError() != tc.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", tc.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestValidateStreamS3EndpointScheme(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		endpoint    string
+		useSsl      types.Bool
+		expectError bool
+	}{
+		{name: "bare host", endpoint: "s3.amazonaws.com", useSsl: types.BoolValue(false)},
+		{name: "https with use_ssl", endpoint: "https://account.r2.cloudflarestorage.com", useSsl: types.BoolValue(true)},
+		{name: "https without use_ssl", endpoint: "https://account.r2.cloudflarestorage.com", useSsl: types.BoolValue(false), expectError: true},
+		{name: "http with use_ssl", endpoint: "http://minio.internal:9000", useSsl: types.BoolValue(true), expectError: true},
+		{name: "url with use_ssl unset", endpoint: "https://s3.wasabisys.com", useSsl: types.BoolNull(), expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateStreamS3EndpointScheme(testStreamModel(t, "s3", map[string]attr.Value{
+				"endpoint": types.StringValue(tc.endpoint),
+				"use_ssl":  tc.useSsl,
+			}))
+
+			if diags.HasError() != tc.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", tc.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestValidateStreamS3ObjectPrefix(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		prefix        types.String
+		expectWarning bool
+	}{
+		{name: "folder prefix", prefix: types.StringValue("streams/")},
+		{name: "unset", prefix: types.StringNull()},
+		{name: "empty", prefix: types.StringValue("")},
+		{name: "no trailing slash", prefix: types.StringValue("streams"), expectWarning: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateStreamS3ObjectPrefix(testStreamModel(t, "s3", map[string]attr.Value{
+				"object_prefix": tc.prefix,
+			}))
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+			if (diags.WarningsCount() > 0) != tc.expectWarning {
+				t.Errorf("expected warning %t, got diagnostics: %v", tc.expectWarning, diags)
+			}
+		})
+	}
+}
+
+func TestValidateStreamPostgresAccessKey(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		destination string
+		attrs       map[string]attr.Value
+		expectError string
+	}{
+		{name: "access_key", destination: "postgres", attrs: map[string]attr.Value{"access_key": types.StringValue("qn-access-key")}},
+		{name: "access_key_wo", destination: "postgres", attrs: map[string]attr.Value{"access_key_wo": types.StringValue("qn-access-key")}},
+		{name: "unknown access_key", destination: "postgres", attrs: map[string]attr.Value{"access_key": types.StringUnknown()}},
+		{name: "other destination", destination: "webhook"},
+		{name: "absent", destination: "postgres"},
+		{name: "empty", destination: "postgres", attrs: map[string]attr.Value{"access_key": types.StringValue("")}, expectError: "access_key must not be empty"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateStreamPostgresAccessKey(testStreamModel(t, tc.destination, tc.attrs))
+
+			if tc.expectError == "" {
+				if diags.HasError() {
+					t.Fatalf("expected no error diagnostics, got: %v", diags.Errors())
+				}
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatalf("expected error diagnostic containing %q", tc.expectError)
+			}
+			if got := diags.Errors()[0].Detail(); !strings.Contains(got, tc.expectError) {
+				t.Errorf("expected diagnostic detail containing %q, got %q", tc.expectError, got)
+			}
+		})
+	}
+}
+
+func TestCheckPostgresAccessKey(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		destination string
+		config      map[string]attr.Value
+		plan        map[string]attr.Value
+		expectError bool
+	}{
+		{name: "access_key", destination: "postgres", config: map[string]attr.Value{"access_key": types.StringValue("qn-access-key")}, plan: map[string]attr.Value{"access_key": types.StringValue("qn-access-key")}},
+		{name: "access_key_wo", destination: "postgres", config: map[string]attr.Value{"access_key_wo": types.StringValue("qn-access-key")}},
+		{name: "kept from state", destination: "postgres", plan: map[string]attr.Value{"access_key": types.StringValue("qn-access-key")}},
+		{name: "other destination", destination: "webhook"},
+		{name: "absent", destination: "postgres", expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := checkPostgresAccessKey(testStreamModel(t, tc.destination, tc.config), testStreamModel(t, tc.destination, tc.plan))
+
+			if diags.HasError() != tc.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", tc.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestValidateStreamDestinationAttributeFields(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		destination   string
+		attrs         map[string]attr.Value
+		expectWarning string
+	}{
+		{name: "webhook fields", destination: "webhook", attrs: map[string]attr.Value{"url": types.StringValue("https://example.com"), "post_timeout_sec": types.Int64Value(10)}},
+		{name: "s3 fields", destination: "s3", attrs: map[string]attr.Value{"bucket": types.StringValue("qn-bucket"), "use_ssl": types.BoolValue(true)}},
+		{name: "postgres fields", destination: "postgres", attrs: map[string]attr.Value{"host": types.StringValue("db.example.com"), "port": types.Int64Value(5432)}},
+		{name: "write-only credentials", destination: "s3", attrs: map[string]attr.Value{"secret_key_wo": types.StringValue("qn-secret"), "credentials_wo_version": types.Int64Value(1)}},
+		{name: "compression left to its own rule", destination: "webhook", attrs: map[string]attr.Value{"file_compression": types.StringValue("gzip")}},
+		{name: "unfiltered destination", destination: "function", attrs: map[string]attr.Value{"bucket": types.StringValue("qn-bucket")}},
+		{name: "s3 field on webhook", destination: "webhook", attrs: map[string]attr.Value{"bucket": types.StringValue("qn-bucket")}, expectWarning: "bucket does not apply to the webhook destination"},
+		{name: "webhook field on postgres", destination: "postgres", attrs: map[string]attr.Value{"headers": types.MapValueMust(types.StringType, map[string]attr.Value{"X-Key": types.StringValue("value")})}, expectWarning: "headers does not apply to the postgres destination"},
+		{name: "write-only version on webhook", destination: "webhook", attrs: map[string]attr.Value{"credentials_wo_version": types.Int64Value(1)}, expectWarning: "credentials_wo_version does not apply to the webhook destination"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateStreamDestinationAttributeFields(testStreamModel(t, tc.destination, tc.attrs))
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			if tc.expectWarning == "" {
+				if diags.WarningsCount() > 0 {
+					t.Fatalf("expected no warning diagnostics, got: %v", diags.Warnings())
+				}
+				return
+			}
+
+			if diags.WarningsCount() != 1 {
+				t.Fatalf("expected one warning diagnostic containing %q, got: %v", tc.expectWarning, diags)
+			}
+			if got := diags.Warnings()[0].Detail(); !strings.Contains(got, tc.expectWarning) {
+				t.Errorf("expected diagnostic detail containing %q, got %q", tc.expectWarning, got)
+			}
+		})
+	}
+}
+
+func TestValidateStreamWebhookContentEncoding(t *testing.T) {
+	headers := func(name, value string) attr.Value {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{name: types.StringValue(value)})
+	}
+
+	for _, tc := range []struct {
+		name        string
+		compression types.String
+		headers     attr.Value
+		expectError string
+	}{
+		{name: "gzip without header", compression: types.StringValue("gzip"), headers: headers("X-Api-Key", "abc")},
+		{name: "gzip with matching header", compression: types.StringValue("gzip"), headers: headers("content-encoding", "GZIP")},
+		{name: "none with identity", compression: types.StringValue("none"), headers: headers("Content-Encoding", "identity")},
+		{name: "unknown compression", compression: types.StringUnknown(), headers: headers("Content-Encoding", "br")},
+		{name: "unknown header", compression: types.StringValue("gzip"), headers: types.MapValueMust(types.StringType, map[string]attr.Value{"Content-Encoding": types.StringUnknown()})},
+		{name: "gzip with conflicting header", compression: types.StringValue("gzip"), headers: headers("Content-Encoding", "br"), expectError: `Expected the Content-Encoding header to be "gzip" for webhook compression "gzip", got: "br"`},
+		{name: "none with gzip header", compression: types.StringValue("none"), headers: headers("Content-Encoding", "gzip"), expectError: `to be "identity" for webhook compression "none"`},
+		{name: "default compression with gzip header", compression: types.StringNull(), headers: headers("CONTENT-ENCODING", "gzip"), expectError: `Expected the CONTENT-ENCODING header to be "identity"`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateStreamWebhookContentEncoding(testStreamModel(t, "webhook", map[string]attr.Value{
+				"compression": tc.compression,
+				"headers":     tc.headers,
+			}))
+
+			if tc.expectError == "" {
+				if diags.HasError() {
+					t.Fatalf("expected no error diagnostics, got: %v", diags.Errors())
+				}
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatalf("expected error diagnostic containing %q", tc.expectError)
+			}
+			if got := diags.Errors()[0].Detail(); !strings.Contains(got, tc.expectError) {
+				t.Errorf("expected diagnostic detail containing %q, got %q", tc.expectError, got)
+			}
+		})
+	}
+}
+
+func TestValidateStreamWebhookRetryInterval(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		destination   string
+		retryInterval types.Int64
+		postTimeout   types.Int64
+		expectError   bool
+	}{
+		{name: "shorter interval", destination: "webhook", retryInterval: types.Int64Value(5), postTimeout: types.Int64Value(10)},
+		{name: "equal", destination: "webhook", retryInterval: types.Int64Value(10), postTimeout: types.Int64Value(10)},
+		{name: "unset post_timeout_sec", destination: "webhook", retryInterval: types.Int64Value(30), postTimeout: types.Int64Null()},
+		{name: "unknown retry_interval_sec", destination: "webhook", retryInterval: types.Int64Unknown(), postTimeout: types.Int64Value(10)},
+		{name: "other destination", destination: "s3", retryInterval: types.Int64Value(30), postTimeout: types.Int64Value(10)},
+		{name: "longer interval", destination: "webhook", retryInterval: types.Int64Value(30), postTimeout: types.Int64Value(10), expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateStreamWebhookRetryInterval(testStreamModel(t, tc.destination, map[string]attr.Value{
+				"retry_interval_sec": tc.retryInterval,
+				"post_timeout_sec":   tc.postTimeout,
+			}))
+
+			if diags.HasError() != tc.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", tc.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestValidateStreamKeepDistanceFromTip(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		network     string
+		distance    int64
+		expectError bool
+	}{
+		{name: "evm within limit", network: "ethereum-mainnet", distance: 10000},
+		{name: "evm above limit", network: "ethereum-mainnet", distance: 50000, expectError: true},
+		{name: "solana slots", network: "solana-mainnet", distance: 50000},
+		{name: "solana beyond evm limit", network: "solana-mainnet", distance: 1000000},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateStreamKeepDistanceFromTip(StreamResourceModel{
+				Network:             types.StringValue(tc.network),
+				KeepDistanceFromTip: types.Int64Value(tc.distance),
+			})
+
+			if diags.HasError() != tc.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", tc.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestValidateStreamDestinationAttributesJson(t *testing.T) {
+	withAttributes := testStreamModel(t, "webhook", map[string]attr.Value{"url": types.StringValue("https://example.com/hook")})
+
+	for _, tc := range []struct {
+		name        string
+		attributes  types.Object
+		attrsJson   types.String
+		expectError string
+	}{
+		{name: "structured", attributes: withAttributes.DestinationAttributes, attrsJson: types.StringNull()},
+		{name: "json object", attributes: types.ObjectNull(destinationAttributesType), attrsJson: types.StringValue(`{"topic":"blocks"}`)},
+		{name: "neither", attributes: types.ObjectNull(destinationAttributesType), attrsJson: types.StringNull(), expectError: "Missing destination attributes"},
+		{name: "both", attributes: withAttributes.DestinationAttributes, attrsJson: types.StringValue(`{}`), expectError: "Conflicting destination attributes"},
+		{name: "invalid json", attributes: types.ObjectNull(destinationAttributesType), attrsJson: types.StringValue(`{"topic":`), expectError: "Invalid destination_attributes_json"},
+		{name: "json array", attributes: types.ObjectNull(destinationAttributesType), attrsJson: types.StringValue(`["blocks"]`), expectError: "Invalid destination_attributes_json"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateStreamDestinationAttributesJson(StreamResourceModel{
+				DestinationAttributes:     tc.attributes,
+				DestinationAttributesJson: tc.attrsJson,
+			})
+
+			if tc.expectError == "" {
+				if diags.HasError() {
+					t.Fatalf("expected no error diagnostics, got: %v", diags.Errors())
+				}
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatalf("expected error diagnostic %q", tc.expectError)
+			}
+			if got := diags.Errors()[0].Summary(); got != tc.expectError {
+				t.Errorf("expected summary %q, got %q", tc.expectError, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var streamTemplateAttributes = map[string]attr.Type{
+	"network":                types.StringType,
+	"dataset":                types.StringType,
+	"region":                 types.StringType,
+	"destination":            types.StringType,
+	"dataset_batch_size":     types.Int64Type,
+	"elastic_batch_enabled":  types.BoolType,
+	"destination_attributes": types.MapType{ElemType: types.StringType},
+}
+
+// StreamTemplateDataSourceModel describes the data structure.
+type StreamTemplateDataSourceModel struct {
+	Network               types.String `tfsdk:"network"`
+	Dataset               types.String `tfsdk:"dataset"`
+	Region                types.String `tfsdk:"region"`
+	Destination           types.String `tfsdk:"destination"`
+	DatasetBatchSize      types.Int64  `tfsdk:"dataset_batch_size"`
+	ElasticBatchEnabled   types.Bool   `tfsdk:"elastic_batch_enabled"`
+	DestinationAttributes types.Map    `tfsdk:"destination_attributes"`
+	Stream                types.Object `tfsdk:"stream"`
+}
+
+var (
+	_ datasource.DataSource              = &StreamTemplateDataSource{}
+	_ datasource.DataSourceWithConfigure = &StreamTemplateDataSource{}
+)
+
+// StreamTemplateDataSource validates a set of stream fields shared by several
+// quicknode_stream resources and returns them normalized the way the resource
+// sends them, so the common values are written and checked once. Fields left
+// unset stay null for each resource to set. It makes no API calls.
+type StreamTemplateDataSource struct {
+	allowUnvalidatedNetwork bool
+}
+
+// Metadata returns the data source type name.
+func (d *StreamTemplateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stream_template"
+}
+
+// Schema defines the schema for the data source.
+func (d *StreamTemplateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Validates stream settings shared by several `quicknode_stream` resources and returns them normalized, with `network` in lower case. Unset fields are left null for each resource to set. No API calls are made.",
+		Attributes: map[string]schema.Attribute{
+			"network": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Network the streams read from",
+			},
+			"dataset": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Dataset the streams deliver",
+				Validators: []validator.String{
+					datasetValidator,
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Region the streams run in",
+				Validators: []validator.String{
+					regionValidator,
+				},
+			},
+			"destination": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Destination type of the streams",
+				Validators: []validator.String{
+					destinationValidator,
+				},
+			},
+			"dataset_batch_size": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Number of blocks per batch",
+				Validators: []validator.Int64{
+					datasetBatchSizeValidator,
+				},
+			},
+			"elastic_batch_enabled": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether elastic batching is enabled",
+			},
+			"destination_attributes": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "String destination attributes shared by the streams, such as `url` or `compression`. Checked against `destination` like the `destination_attributes` of `quicknode_stream`.",
+			},
+			"stream": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The template fields, normalized",
+				Attributes: map[string]schema.Attribute{
+					"network": schema.StringAttribute{
+						Computed: true,
+					},
+					"dataset": schema.StringAttribute{
+						Computed: true,
+					},
+					"region": schema.StringAttribute{
+						Computed: true,
+					},
+					"destination": schema.StringAttribute{
+						Computed: true,
+					},
+					"dataset_batch_size": schema.Int64Attribute{
+						Computed: true,
+					},
+					"elastic_batch_enabled": schema.BoolAttribute{
+						Computed: true,
+					},
+					"destination_attributes": schema.MapAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured settings to the data source.
+func (d *StreamTemplateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	qnd, ok := req.ProviderData.(QuickNodeData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData type",
+			fmt.Sprintf("Expected QuickNodeData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.allowUnvalidatedNetwork = qnd.AllowUnvalidatedNetwork
+}
+
+// Read reads the data source.
+func (d *StreamTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StreamTemplateDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkNetwork(ctx, data.Network, d.allowUnvalidatedNetwork)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateStreamTemplateDestination(data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stream, diags := buildStreamTemplate(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Stream = stream
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildStreamTemplate returns the configured template fields with network in
+// the case the stream resource sends it. Unset fields are left null, since
+// the stream resource has no default for them.
+func buildStreamTemplate(data StreamTemplateDataSourceModel) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(streamTemplateAttributes, map[string]attr.Value{
+		"network":                types.StringValue(canonicalNetwork(data.Network)),
+		"dataset":                data.Dataset,
+		"region":                 data.Region,
+		"destination":            data.Destination,
+		"dataset_batch_size":     data.DatasetBatchSize,
+		"elastic_batch_enabled":  data.ElasticBatchEnabled,
+		"destination_attributes": data.DestinationAttributes,
+	})
+}
+
+// validateStreamTemplateDestination checks destination_attributes holds only
+// string fields of the stream resource's destination_attributes, and runs the
+// stream resource's destination checks against them.
+func validateStreamTemplateDestination(data StreamTemplateDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.DestinationAttributes.IsNull() || data.DestinationAttributes.IsUnknown() {
+		return diags
+	}
+
+	values := nullDestinationAttributes()
+	for name, value := range data.DestinationAttributes.Elements() {
+		if destinationAttributesType[name] != types.StringType {
+			diags.AddAttributeError(
+				path.Root("destination_attributes").AtMapKey(name),
+				"Invalid destination attribute",
+				fmt.Sprintf("%s is not a string field of the quicknode_stream destination_attributes", name),
+			)
+			continue
+		}
+		values[name] = value
+	}
+	if diags.HasError() || data.Destination.IsNull() {
+		return diags
+	}
+
+	destinationAttributes, d := types.ObjectValue(destinationAttributesType, values)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	stream := StreamResourceModel{
+		Destination:           data.Destination,
+		DestinationAttributes: destinationAttributes,
+	}
+	for _, validate := range []func(StreamResourceModel) diag.Diagnostics{
+		validateStreamCompression,
+		validateStreamDestinationAttributeFields,
+	} {
+		// The stream resource reports fields of its destination_attributes
+		// object, which are keys of the template's map.
+		for _, d := range validate(stream) {
+			withPath, ok := d.(diag.DiagnosticWithPath)
+			if !ok {
+				diags.Append(d)
+				continue
+			}
+			attributePath := path.Root("destination_attributes")
+			if step, _ := withPath.Path().Steps().LastStep(); step != nil {
+				if name, ok := step.(path.PathStepAttributeName); ok {
+					attributePath = attributePath.AtMapKey(string(name))
+				}
+			}
+			if d.Severity() == diag.SeverityError {
+				diags.AddAttributeError(attributePath, d.Summary(), d.Detail())
+			} else {
+				diags.AddAttributeWarning(attributePath, d.Summary(), d.Detail())
+			}
+		}
+	}
+
+	return diags
+}
+
+// NewStreamTemplateDataSource returns a new instance of the data source.
+func NewStreamTemplateDataSource() datasource.DataSource {
+	return &StreamTemplateDataSource{}
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestBuildStreamTemplate(t *testing.T) {
+	stream, diags := buildStreamTemplate(StreamTemplateDataSourceModel{
+		Network:             types.StringValue("Ethereum-Mainnet"),
+		Dataset:             types.StringValue("block"),
+		Region:              types.StringNull(),
+		Destination:         types.StringValue("webhook"),
+		DatasetBatchSize:    types.Int64Value(10),
+		ElasticBatchEnabled: types.BoolNull(),
+		DestinationAttributes: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"url": types.StringValue("https://example.com/hook"),
+		}),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expected := map[string]attr.Value{
+		"network":               types.StringValue("ethereum-mainnet"),
+		"dataset":               types.StringValue("block"),
+		"region":                types.StringNull(),
+		"destination":           types.StringValue("webhook"),
+		"dataset_batch_size":    types.Int64Value(10),
+		"elastic_batch_enabled": types.BoolNull(),
+		"destination_attributes": types.MapValueMust(types.StringType, map[string]attr.Value{
+			"url": types.StringValue("https://example.com/hook"),
+		}),
+	}
+
+	got := stream.Attributes()
+	for name, want := range expected {
+		if !got[name].Equal(want) {
+			t.Errorf("%s: expected %v, got %v", name, want, got[name])
+		}
+	}
+}
+
+func TestValidateStreamTemplateDestination(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		destination   types.String
+		attributes    map[string]attr.Value
+		expectError   bool
+		expectWarning bool
+	}{
+		{
+			name:        "webhook fields",
+			destination: types.StringValue("webhook"),
+			attributes:  map[string]attr.Value{"url": types.StringValue("https://example.com/hook"), "compression": types.StringValue("gzip")},
+		},
+		{
+			name:        "no destination",
+			destination: types.StringNull(),
+			attributes:  map[string]attr.Value{"bucket": types.StringValue("logs")},
+		},
+		{
+			name:        "unknown field",
+			destination: types.StringValue("webhook"),
+			attributes:  map[string]attr.Value{"uri": types.StringValue("https://example.com/hook")},
+			expectError: true,
+		},
+		{
+			name:        "non-string field",
+			destination: types.StringNull(),
+			attributes:  map[string]attr.Value{"max_retry": types.StringValue("3")},
+			expectError: true,
+		},
+		{
+			name:        "invalid compression",
+			destination: types.StringValue("webhook"),
+			attributes:  map[string]attr.Value{"compression": types.StringValue("brotli")},
+			expectError: true,
+		},
+		{
+			name:          "field of another destination",
+			destination:   types.StringValue("webhook"),
+			attributes:    map[string]attr.Value{"bucket": types.StringValue("logs")},
+			expectWarning: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateStreamTemplateDestination(StreamTemplateDataSourceModel{
+				Destination:           tc.destination,
+				DestinationAttributes: types.MapValueMust(types.StringType, tc.attributes),
+			})
+
+			if diags.HasError() != tc.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", tc.expectError, diags)
+			}
+			if (diags.WarningsCount() > 0) != tc.expectWarning {
+				t.Errorf("expected warning %t, got diagnostics: %v", tc.expectWarning, diags)
+			}
+		})
+	}
+}
+
+func TestStreamTemplateDataSource_UnvalidatedNetwork(t *testing.T) {
+	read := func(allowUnvalidatedNetwork bool) *datasource.ReadResponse {
+		d := NewStreamTemplateDataSource().(*StreamTemplateDataSource)
+		d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: QuickNodeData{AllowUnvalidatedNetwork: allowUnvalidatedNetwork}}, &datasource.ConfigureResponse{})
+
+		var schemaResp datasource.SchemaResponse
+		d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+		objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["network"] = tftypes.NewValue(tftypes.String, "newchain-testnet")
+		values["dataset"] = tftypes.NewValue(tftypes.String, "block")
+
+		resp := &datasource.ReadResponse{
+			State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+		}
+		d.Read(context.Background(), datasource.ReadRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+		}, resp)
+		return resp
+	}
+
+	if resp := read(false); !resp.Diagnostics.HasError() {
+		t.Fatalf("expected an error for an unknown network without allow_unvalidated_network")
+	}
+
+	resp := read(true)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("expected a warning that the network is not validated, got %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/circlefin/terraform-provider-quicknode/api/streams"
+	"github.com/circlefin/terraform-provider-quicknode/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StreamsUsageDataSource reports how many streams the account has enabled, the
+// only usage figure the Streams API exposes.
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &StreamsUsageDataSource{}
+	_ datasource.DataSourceWithConfigure = &StreamsUsageDataSource{}
+)
+
+// StreamsUsageDataSourceModel describes the data structure.
+type StreamsUsageDataSourceModel struct {
+	EnabledCount types.Int64 `tfsdk:"enabled_count"`
+}
+
+// StreamsUsageDataSource implements datasource.DataSource.
+type StreamsUsageDataSource struct {
+	client streams.ClientWithResponsesInterface
+
+	verboseErrors bool
+}
+
+// Metadata returns the data source type name.
+func (d *StreamsUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_streams_usage"
+}
+
+// Schema defines the schema for the data source.
+func (d *StreamsUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports Streams usage of the account. If the API key cannot access Streams, a warning is shown and the usage is null.",
+		Attributes: map[string]schema.Attribute{
+			"enabled_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of enabled streams",
+			},
+		},
+	}
+}
+
+func (d *StreamsUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	qnd, ok := req.ProviderData.(QuickNodeData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData type",
+			fmt.Sprintf("Expected QuickNodeData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = qnd.StreamsClient
+	d.verboseErrors = qnd.VerboseErrors
+}
+
+// Read reads the data source.
+func (d *StreamsUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	data := StreamsUsageDataSourceModel{EnabledCount: types.Int64Null()}
+
+	countResp, err := d.client.GetEnabledStreamsWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("%s - Reading Streams Usage", utils.ClientErrorSummary),
+			utils.BuildClientErrorMessage(err),
+		)
+		return
+	}
+
+	switch countResp.StatusCode() {
+	case http.StatusOK:
+	case http.StatusUnauthorized, http.StatusForbidden:
+		resp.Diagnostics.AddWarning(
+			"Streams Usage Unavailable",
+			fmt.Sprintf("The API key cannot read Streams usage (%s), so the usage is left null.", countResp.Status()),
+		)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	default:
+		m, err := utils.BuildRequestErrorMessage(countResp.Status(), countResp.Body, d.verboseErrors)
+		if err != nil {
+			resp.Diagnostics.AddWarning(fmt.Sprintf("%s - Reading Streams Usage", utils.InternalErrorSummary), utils.BuildInternalErrorMessage(err))
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("%s - Reading Streams Usage", utils.RequestErrorSummary),
+			m,
+		)
+		return
+	}
+
+	count, err := parseEnabledStreamsCount(countResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing response", fmt.Sprintf("Could not parse enabled stream count from API: %v", err))
+		return
+	}
+	data.EnabledCount = types.Int64Value(count)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parseEnabledStreamsCount reads the count of enabled streams, a bare number
+// or one in a {"data": ...} envelope.
+func parseEnabledStreamsCount(body []byte) (int64, error) {
+	var count json.Number
+	if err := json.Unmarshal(unwrapDataEnvelope(body), &count); err != nil {
+		return 0, err
+	}
+	return count.Int64()
+}
+
+// NewStreamsUsageDataSource returns a new instance of the data source.
+func NewStreamsUsageDataSource() datasource.DataSource {
+	return &StreamsUsageDataSource{}
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/circlefin/terraform-provider-quicknode/api/streams"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// enabledCountStubClient serves a fixed enabled_count response. Any other call
+// panics via the nil embedded interface.
+type enabledCountStubClient struct {
+	streams.ClientWithResponsesInterface
+
+	status int
+	body   string
+}
+
+func (s *enabledCountStubClient) GetEnabledStreamsWithResponse(_ context.Context, _ ...streams.RequestEditorFn) (*streams.GetEnabledStreamsResponse, error) {
+	return &streams.GetEnabledStreamsResponse{HTTPResponse: testStubResponse(s.status), Body: []byte(s.body)}, nil
+}
+
+func readStreamsUsageDataSource(t *testing.T, client *enabledCountStubClient) (StreamsUsageDataSourceModel, *datasource.ReadResponse) {
+	t.Helper()
+
+	d := NewStreamsUsageDataSource().(*StreamsUsageDataSource)
+	d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: QuickNodeData{StreamsClient: client}}, &datasource.ConfigureResponse{})
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	d.Read(context.Background(), datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}, resp)
+
+	var result StreamsUsageDataSourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &result)...)
+	}
+	return result, resp
+}
+
+func TestStreamsUsageDataSource(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		body string
+	}{
+		{"bare number", `3`},
+		{"data envelope", `{"data":3,"error":null}`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result, resp := readStreamsUsageDataSource(t, &enabledCountStubClient{status: http.StatusOK, body: tc.body})
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !result.EnabledCount.Equal(types.Int64Value(3)) {
+				t.Errorf("expected enabled_count 3, got %v", result.EnabledCount)
+			}
+		})
+	}
+}
+
+func TestStreamsUsageDataSource_NoAccess(t *testing.T) {
+	result, resp := readStreamsUsageDataSource(t, &enabledCountStubClient{status: http.StatusForbidden, body: `{}`})
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("expected a warning, got %v", resp.Diagnostics)
+	}
+	if !result.EnabledCount.IsNull() {
+		t.Errorf("expected enabled_count to be null, got %v", result.EnabledCount)
+	}
+}
+
+func TestStreamsUsageDataSource_UnexpectedBody(t *testing.T) {
+	_, resp := readStreamsUsageDataSource(t, &enabledCountStubClient{status: http.StatusOK, body: `{"streams":[]}`})
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected an error for a response without a count")
+	}
+}
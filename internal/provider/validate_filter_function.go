@@ -0,0 +1,130 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dop251/goja/ast"
+	"github.com/dop251/goja/parser"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ValidateFilterFunction{}
+
+// ValidateFilterFunction checks the syntax of stream filter source without
+// reading it through the quicknode_filter data source.
+type ValidateFilterFunction struct{}
+
+func NewValidateFilterFunction() function.Function {
+	return &ValidateFilterFunction{}
+}
+
+func (f *ValidateFilterFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_filter"
+}
+
+func (f *ValidateFilterFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Checks the syntax of a stream filter",
+		MarkdownDescription: "Returns an empty string if the JavaScript filter source parses and defines `main` at the top level, or a description of the first problem found otherwise. " +
+			"The source is parsed as ECMAScript but not run. " +
+			"Intended for `precondition` blocks, such as `provider::quicknode::validate_filter(file(\"filter.js\")) == \"\"`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "code",
+				MarkdownDescription: "JavaScript filter source, not base64 encoded",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ValidateFilterFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var code string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &code))
+	if resp.Error != nil {
+		return
+	}
+
+	result := ""
+	if err := checkFilterSource(code); err != nil {
+		result = err.Error()
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// checkFilterSource parses JavaScript filter source and reports the first
+// syntax error, or that it does not define a top-level main function for the
+// Streams filter runtime to call.
+func checkFilterSource(code string) error {
+	if strings.TrimSpace(code) == "" {
+		return fmt.Errorf("filter is empty")
+	}
+
+	program, err := parser.ParseFile(nil, "", code, 0)
+	if err != nil {
+		var errs parser.ErrorList
+		if errors.As(err, &errs) && len(errs) > 0 {
+			return fmt.Errorf("line %d, column %d: %s", errs[0].Position.Line, errs[0].Position.Column, errs[0].Message)
+		}
+		return err
+	}
+
+	if !definesMain(program) {
+		return fmt.Errorf("filter must define a main function, such as: function main(stream) { ... }")
+	}
+
+	return nil
+}
+
+// definesMain reports whether program declares main at the top level, as a
+// function or a variable.
+func definesMain(program *ast.Program) bool {
+	isMain := func(bindings []*ast.Binding) bool {
+		for _, binding := range bindings {
+			if id, ok := binding.Target.(*ast.Identifier); ok && id.Name == "main" {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, statement := range program.Body {
+		switch statement := statement.(type) {
+		case *ast.FunctionDeclaration:
+			if statement.Function.Name != nil && statement.Function.Name.Name == "main" {
+				return true
+			}
+		case *ast.VariableStatement:
+			if isMain(statement.List) {
+				return true
+			}
+		case *ast.LexicalDeclaration:
+			if isMain(statement.List) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runValidateFilter(t *testing.T, code string) string {
+	t.Helper()
+
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewValidateFilterFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(code)}),
+	}, &resp)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	return resp.Result.Value().(types.String).ValueString()
+}
+
+func TestValidateFilterFunction(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		code     string
+		expected string
+	}{
+		{
+			"valid filter",
+			`// Keep blocks with transactions to 0xabc.
+function main(stream) {
+  const re = /^0xabc[/]?$/i;
+  const blocks = stream.data.filter(b => b.transactions.some(tx => re.test(tx.to)));
+  /* "quotes" and {braces} in comments are ignored */
+  return blocks.length ? { blocks, note: ` + "`${blocks.length} block${blocks.length / 2 > 1 ? 's' : ''}`" + ` } : null;
+}`,
+			"",
+		},
+		{"arrow function main", "const main = (stream) => stream.data;", ""},
+		{"postfix increment before division", "function main(s){ let y = x++ / 2; return s }", ""},
+		{"empty", "  \n", "filter is empty"},
+		{"missing main", "function filter(stream) { return stream; }", "must define a main function"},
+		{"main only in comment", "// function main(stream) {}\nfunction filter(stream) { return 'main'; }", "must define a main function"},
+		{"nested main", "function outer() { function main(stream) { return stream; } }", "must define a main function"},
+		{"unclosed brace", "function main(stream) {\n  return stream;\n", "line 3, column 1: Unexpected end of input"},
+		{"mismatched bracket", "function main(stream) { return [stream); }", "line 1, column 39: Unexpected token )"},
+		{"unterminated string", "function main(stream) {\n  return 'abc;\n}", "line 2, column 10: "},
+		{"unterminated comment", "function main(stream) { /* todo }", "line 1, column 34: Unexpected end of input"},
+		{"unterminated template", "function main(stream) { return `${stream}; }", "line 1, column 45: Unexpected end of input"},
+		{"unterminated regexp", "function main(stream) { return /abc; }\n", "line 1, column 32: Invalid regular expression"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := runValidateFilter(t, tc.code)
+			if tc.expected == "" {
+				if got != "" {
+					t.Errorf("expected filter to be valid, got %q", got)
+				}
+				return
+			}
+			if !strings.Contains(got, tc.expected) {
+				t.Errorf("expected result to contain %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -18,7 +18,11 @@ package utils
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+
+	"github.com/circlefin/terraform-provider-quicknode/internal/client/transport"
 )
 
 const (
@@ -31,25 +35,99 @@ type ErrorResponse struct {
 	Error *string `json:"error"`
 }
 
-func BuildRequestErrorMessage(status string, body []byte) (string, error) {
+// BuildRequestErrorMessage describes an unexpected response. verbose adds the
+// redacted response body, as configured by the provider's verbose_errors.
+func BuildRequestErrorMessage(status string, body []byte, verbose bool) (string, error) {
 	m := fmt.Sprintf("Did not get expected status code, got status code `%s`", status)
 
+	var err error
 	if len(body) != 0 {
 		var e ErrorResponse
-		err := json.Unmarshal(body, &e)
-		if err != nil {
-			return m, err
+		err = json.Unmarshal(body, &e)
+		if err == nil && e.Error != nil {
+			m += fmt.Sprintf("\nerror `%s`", *e.Error)
 		}
+	}
 
-		if e.Error != nil {
-			m += fmt.Sprintf("\nerror `%s`", *e.Error)
+	if verbose && len(body) != 0 {
+		if redacted, ok := redactBody(body); ok {
+			m += fmt.Sprintf("\nresponse body `%s`", redacted)
+		} else {
+			m += "\nresponse body omitted, it is not JSON so credentials in it cannot be redacted"
+		}
+	}
+
+	return m, err
+}
+
+// maxErrorBodyBytes bounds the response body included in verbose errors.
+const maxErrorBodyBytes = 4096
+
+// sensitiveBodyFields are substrings of the names of JSON fields whose values
+// are redacted from verbose errors, such as access_key or security_token.
+var sensitiveBodyFields = []string{"key", "token", "secret", "password", "authorization", "credential"}
+
+// redactBody returns the JSON body with the values of sensitive fields
+// replaced, truncated to maxErrorBodyBytes. It reports false if body is not
+// JSON, since it cannot then be redacted by field.
+func redactBody(body []byte) (string, bool) {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return "", false
+	}
+
+	redacted, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return "", false
+	}
+
+	if len(redacted) > maxErrorBodyBytes {
+		return string(redacted[:maxErrorBodyBytes]) + "...", true
+	}
+	return string(redacted), true
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for name, value := range v {
+			if isSensitiveBodyField(name) && value != nil {
+				v[name] = "REDACTED"
+				continue
+			}
+			v[name] = redactValue(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactValue(value)
 		}
 	}
+	return v
+}
 
-	return m, nil
+func isSensitiveBodyField(name string) bool {
+	name = strings.ToLower(name)
+	for _, field := range sensitiveBodyFields {
+		if strings.Contains(name, field) {
+			return true
+		}
+	}
+	return false
 }
 
 func BuildClientErrorMessage(err error) string {
+	var rateLimited *transport.RateLimitedError
+	if errors.As(err, &rateLimited) {
+		return fmt.Sprintf("Unable to make request, the API was still rate limiting requests after %d attempt(s). "+
+			"Lower requests_per_second or max_concurrent_requests, or check the rate limits of your QuickNode plan.", rateLimited.Attempts)
+	}
+
+	var budgetExceeded *transport.BudgetExceededError
+	if errors.As(err, &budgetExceeded) {
+		return fmt.Sprintf("Unable to make request, the provider has already made the %d API calls allowed by max_api_calls. "+
+			"Raise max_api_calls if this run is expected to make more requests.", budgetExceeded.Limit)
+	}
+
 	m := fmt.Sprintf("Unable to make request, got error: %s", err)
 
 	return m
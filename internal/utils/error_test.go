@@ -0,0 +1,47 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/circlefin/terraform-provider-quicknode/internal/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildRequestErrorMessage_VerboseErrors(t *testing.T) {
+	body := []byte(`{"error":"invalid destination","data":{"destination_attributes":{"bucket":"logs","access_key":"AKIA123","secret_key":"s3cr3t"}}}`)
+
+	m, err := utils.BuildRequestErrorMessage("400 Bad Request", body, false)
+	assert.NoError(t, err)
+	assert.Contains(t, m, "invalid destination")
+	assert.NotContains(t, m, "response body")
+
+	m, err = utils.BuildRequestErrorMessage("400 Bad Request", body, true)
+	assert.NoError(t, err)
+	assert.Contains(t, m, "response body")
+	assert.Contains(t, m, `"bucket":"logs"`)
+	assert.NotContains(t, m, "AKIA123")
+	assert.NotContains(t, m, "s3cr3t")
+}
+
+func TestBuildRequestErrorMessage_VerboseErrorsNonJSON(t *testing.T) {
+	m, err := utils.BuildRequestErrorMessage("502 Bad Gateway", []byte("<html>token=s3cr3t</html>"), true)
+	assert.Error(t, err)
+	assert.Contains(t, m, "response body omitted")
+	assert.NotContains(t, m, "s3cr3t")
+}
@@ -0,0 +1,36 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// JitterFraction is how far Jitter may move an interval, as a fraction of it.
+const JitterFraction = 0.2
+
+// Jitter returns d moved by a random amount of at most JitterFraction of d in
+// either direction, so resources polling in parallel do not do so in lockstep.
+func Jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return d
+	}
+
+	spread := float64(d) * JitterFraction
+	return d + time.Duration(spread*(2*rand.Float64()-1))
+}
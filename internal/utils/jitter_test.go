@@ -0,0 +1,44 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/circlefin/terraform-provider-quicknode/internal/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJitter(t *testing.T) {
+	interval := 2 * time.Second
+	low := time.Duration(float64(interval) * (1 - utils.JitterFraction))
+	high := time.Duration(float64(interval) * (1 + utils.JitterFraction))
+
+	seen := map[time.Duration]bool{}
+	for range 100 {
+		d := utils.Jitter(interval)
+		assert.GreaterOrEqual(t, d, low)
+		assert.LessOrEqual(t, d, high)
+		seen[d] = true
+	}
+	assert.Greater(t, len(seen), 1, "expected jittered intervals to vary")
+}
+
+func TestJitter_NonPositive(t *testing.T) {
+	assert.Equal(t, time.Duration(0), utils.Jitter(0))
+}
@@ -0,0 +1,39 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validators
+
+import "strings"
+
+// unboundedKeepDistancePrefixes are the network slug prefixes of streams whose
+// keep_distance_from_tip is not held to MaxKeepDistanceFromTip. Solana counts
+// it in slots, which are produced far more often than blocks, and the Streams
+// API sets no limit of its own.
+var unboundedKeepDistancePrefixes = []string{"solana-"}
+
+const MaxKeepDistanceFromTip = 10000
+
+// KeepDistanceFromTipLimit returns the largest keep_distance_from_tip allowed
+// on a stream network, ignoring case, or false if it has no limit.
+func KeepDistanceFromTipLimit(network string) (int64, bool) {
+	network = strings.ToLower(network)
+	for _, prefix := range unboundedKeepDistancePrefixes {
+		if strings.HasPrefix(network, prefix) {
+			return 0, false
+		}
+	}
+	return MaxKeepDistanceFromTip, true
+}
@@ -0,0 +1,36 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/circlefin/terraform-provider-quicknode/internal/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeepDistanceFromTipLimit(t *testing.T) {
+	limit, ok := validators.KeepDistanceFromTipLimit("ethereum-mainnet")
+	assert.True(t, ok)
+	assert.Equal(t, int64(validators.MaxKeepDistanceFromTip), limit)
+
+	_, ok = validators.KeepDistanceFromTipLimit("solana-devnet")
+	assert.False(t, ok)
+
+	_, ok = validators.KeepDistanceFromTipLimit("Solana-Mainnet")
+	assert.False(t, ok)
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// hostPattern matches a host name or IPv4 address with an optional port.
+var hostPattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9.-]*[A-Za-z0-9])?(:[0-9]{1,5})?$`)
+
+// SplitS3Endpoint returns the host the Streams API expects for an S3
+// endpoint given either as a bare host, such as s3.amazonaws.com, or as an
+// http or https URL, such as https://example.r2.cloudflarestorage.com. scheme
+// is empty for a bare host.
+func SplitS3Endpoint(endpoint string) (host, scheme string, err error) {
+	value := strings.TrimSuffix(endpoint, "/")
+
+	if strings.Contains(value, "://") {
+		u, err := url.Parse(value)
+		if err != nil {
+			return "", "", fmt.Errorf("%q is not a valid URL: %w", endpoint, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return "", "", fmt.Errorf("%q must use http or https", endpoint)
+		}
+		if u.User != nil || u.Path != "" || u.RawQuery != "" || u.Fragment != "" {
+			return "", "", fmt.Errorf("%q must not include credentials, a path or a query, set the bucket and object_prefix instead", endpoint)
+		}
+		value, scheme = u.Host, u.Scheme
+	}
+
+	if !hostPattern.MatchString(value) {
+		return "", "", fmt.Errorf("%q is not a valid host or URL", endpoint)
+	}
+
+	return value, scheme, nil
+}
+
+// S3EndpointValidator checks a value is a host or http(s) URL accepted by
+// SplitS3Endpoint.
+type S3EndpointValidator struct{}
+
+func (v S3EndpointValidator) Description(ctx context.Context) string {
+	return "value must be a host such as s3.amazonaws.com or an http(s) URL"
+}
+
+func (v S3EndpointValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a host such as `s3.amazonaws.com` or an http(s) URL"
+}
+
+func (v S3EndpointValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, _, err := SplitS3Endpoint(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid value",
+			fmt.Sprintf("Expected an S3 endpoint host or URL, %s", err),
+		)
+	}
+}
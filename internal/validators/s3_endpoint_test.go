@@ -0,0 +1,73 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/circlefin/terraform-provider-quicknode/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitS3Endpoint(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		endpoint    string
+		host        string
+		scheme      string
+		expectError bool
+	}{
+		{name: "aws host", endpoint: "s3.amazonaws.com", host: "s3.amazonaws.com"},
+		{name: "r2 url", endpoint: "https://account.r2.cloudflarestorage.com", host: "account.r2.cloudflarestorage.com", scheme: "https"},
+		{name: "minio url with port", endpoint: "http://minio.internal:9000/", host: "minio.internal:9000", scheme: "http"},
+		{name: "unsupported scheme", endpoint: "ftp://s3.amazonaws.com", expectError: true},
+		{name: "url with path", endpoint: "https://s3.amazonaws.com/bucket", expectError: true},
+		{name: "not a host", endpoint: "not a host", expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			host, scheme, err := validators.SplitS3Endpoint(tc.endpoint)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.host, host)
+				assert.Equal(t, tc.scheme, scheme)
+			}
+		})
+	}
+}
+
+func TestS3EndpointValidator(t *testing.T) {
+	for value, expectError := range map[string]bool{
+		"s3.amazonaws.com":                         false,
+		"https://account.r2.cloudflarestorage.com": false,
+		"https://s3.amazonaws.com/bucket":          true,
+	} {
+		resp := &validator.StringResponse{}
+		validators.S3EndpointValidator{}.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("endpoint"),
+			ConfigValue: types.StringValue(value),
+		}, resp)
+
+		assert.Equal(t, expectError, resp.Diagnostics.HasError(), value)
+	}
+}
@@ -19,15 +19,19 @@ package validators
 import (
 	"context"
 	"fmt"
+	"math"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/circlefin/terraform-provider-quicknode/api/streams"
 )
 
 type StringOneOfValidator struct {
-	values []string
+	values     []string
+	ignoreCase bool
 }
 
 func (v StringOneOfValidator) Description(ctx context.Context) string {
@@ -46,7 +50,7 @@ func (v StringOneOfValidator) ValidateString(ctx context.Context, req validator.
 	value := req.ConfigValue.ValueString()
 
 	for _, validValue := range v.values {
-		if value == validValue {
+		if value == validValue || (v.ignoreCase && strings.EqualFold(value, validValue)) {
 			return
 		}
 	}
@@ -116,10 +120,151 @@ func (v Int64RangeValidator) ValidateInt64(ctx context.Context, req validator.In
 	}
 }
 
+// ListInt64ElementsValidator applies an Int64RangeValidator to every element of
+// a list of numbers.
+type ListInt64ElementsValidator struct {
+	element Int64RangeValidator
+}
+
+func (v ListInt64ElementsValidator) Description(ctx context.Context) string {
+	return "each " + v.element.Description(ctx)
+}
+
+func (v ListInt64ElementsValidator) MarkdownDescription(ctx context.Context) string {
+	return "each " + v.element.MarkdownDescription(ctx)
+}
+
+func (v ListInt64ElementsValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for i, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.Int64)
+		if !ok {
+			continue
+		}
+
+		elementResp := &validator.Int64Response{}
+		v.element.ValidateInt64(ctx, validator.Int64Request{
+			Path:        req.Path.AtListIndex(i),
+			ConfigValue: value,
+		}, elementResp)
+		resp.Diagnostics.Append(elementResp.Diagnostics...)
+	}
+}
+
+// MapKeyNotOneOfValidator rejects map keys matching any of values, ignoring case.
+type MapKeyNotOneOfValidator struct {
+	values  []string
+	summary string
+}
+
+func (v MapKeyNotOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("keys must not be any of: %v", v.values)
+}
+
+func (v MapKeyNotOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("keys must not be any of: %v", v.values)
+}
+
+func (v MapKeyNotOneOfValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for key := range req.ConfigValue.Elements() {
+		for _, invalidValue := range v.values {
+			if strings.EqualFold(key, invalidValue) {
+				resp.Diagnostics.AddAttributeError(
+					req.Path.AtMapKey(key),
+					v.summary,
+					fmt.Sprintf("%s cannot be set, keys must not be any of: %v", key, v.values),
+				)
+			}
+		}
+	}
+}
+
+// MapSizeValidator caps the number of entries of a string map and their total
+// size when serialized as HTTP headers ("Key: Value\r\n" per entry).
+type MapSizeValidator struct {
+	maxEntries int
+	maxBytes   int
+}
+
+func (v MapSizeValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("at most %d entries totalling at most %d bytes", v.maxEntries, v.maxBytes)
+}
+
+func (v MapSizeValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("at most %d entries totalling at most %d bytes", v.maxEntries, v.maxBytes)
+}
+
+func (v MapSizeValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+	if len(elements) > v.maxEntries {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Too many headers",
+			fmt.Sprintf("Expected at most %d headers, got: %d", v.maxEntries, len(elements)),
+		)
+	}
+
+	size := 0
+	for key, value := range elements {
+		s, ok := value.(types.String)
+		if !ok || s.IsUnknown() {
+			continue
+		}
+		size += len(key) + len(": ") + len(s.ValueString()) + len("\r\n")
+	}
+
+	if size > v.maxBytes {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Headers too large",
+			fmt.Sprintf("Expected headers to total at most %d bytes when sent, got: %d", v.maxBytes, size),
+		)
+	}
+}
+
+var (
+	// ReservedWebhookHeaders are set by QuickNode when delivering to a webhook
+	// and cannot be overridden through headers.
+	ReservedWebhookHeaders = []string{"Authorization", "Host", "Content-Length"}
+
+	WebhookHeadersValidator = MapKeyNotOneOfValidator{
+		values:  ReservedWebhookHeaders,
+		summary: "Reserved header",
+	}
+)
+
+const (
+	// MaxWebhookHeaders and MaxWebhookHeadersBytes bound the custom headers
+	// sent with each webhook delivery. The Streams API does not publish a
+	// limit, so these stay well within the 8 KiB of headers most receivers
+	// and proxies accept.
+	MaxWebhookHeaders      = 50
+	MaxWebhookHeadersBytes = 4096
+)
+
+// MaxEndRange is the largest end_range a stream can be configured with.
+const MaxEndRange = 999999999999
+
+var WebhookHeadersSizeValidator = MapSizeValidator{
+	maxEntries: MaxWebhookHeaders,
+	maxBytes:   MaxWebhookHeadersBytes,
+}
+
 var (
 	// Network, Dataset, Destination, and Region values are generated from the
 	// OpenAPI spec (see api/streams/enums.gen.go) and refreshed by `make vendor`.
-	NetworkValidator = StringOneOfValidator{values: streams.Networks}
+	NetworkValidator = StringOneOfValidator{values: streams.Networks, ignoreCase: true}
 
 	DatasetValidator = StringOneOfValidator{values: streams.Datasets}
 
@@ -137,13 +282,17 @@ var (
 
 	RegionValidator = StringOneOfValidator{values: streams.Regions}
 
-	CompressionValidator = StringOneOfValidator{
-		values: []string{"none", "gzip"},
+	MetricsPeriodValidator = StringOneOfValidator{
+		values: []string{"hour", "day", "week", "month"},
 	}
 
-	FileCompressionValidator = StringOneOfValidator{
-		values: []string{"none", "gzip"},
-	}
+	// WebhookCompressions and FileCompressions are checked per destination by
+	// the stream resource's ValidateConfig rather than by attribute validators,
+	// since compression and file_compression share one destination_attributes
+	// object and only one of them applies to a given destination.
+	WebhookCompressions = []string{"none", "gzip"}
+
+	FileCompressions = []string{"none", "gzip"}
 
 	FileTypeValidator = StringOneOfValidator{
 		values: []string{".json", ".parquet"},
@@ -163,6 +312,19 @@ var (
 		message: "Invalid email format",
 	}
 
+	// StreamNameValidator and EndpointLabelValidator reject names QuickNode
+	// cannot store. The APIs publish no naming rules, so only empty names and
+	// control characters are refused.
+	StreamNameValidator = StringRegexpValidator{
+		regexp:  regexp.MustCompile(`^[^\p{Cc}]+$`),
+		message: "stream name must not be empty or contain control characters such as newlines",
+	}
+
+	EndpointLabelValidator = StringRegexpValidator{
+		regexp:  regexp.MustCompile(`^[^\p{Cc}]+$`),
+		message: "endpoint label must not be empty or contain control characters such as newlines",
+	}
+
 	StartRangeValidator = Int64RangeValidator{
 		min: 0,
 		max: 999999999999,
@@ -170,7 +332,7 @@ var (
 
 	EndRangeValidator = Int64RangeValidator{
 		min: 0,
-		max: 999999999999,
+		max: MaxEndRange,
 	}
 
 	DatasetBatchSizeValidator = Int64RangeValidator{
@@ -183,9 +345,11 @@ var (
 		max: 1,
 	}
 
+	// KeepDistanceFromTipValidator allows any distance; the limit of the
+	// configured network is checked with the whole stream.
 	KeepDistanceFromTipValidator = Int64RangeValidator{
 		min: 0,
-		max: 10000,
+		max: math.MaxInt32,
 	}
 
 	MaxRetryValidator = Int64RangeValidator{
@@ -207,4 +371,58 @@ var (
 		min: 1,
 		max: 65535,
 	}
+
+	StreamReadRetriesValidator = Int64RangeValidator{
+		min: 0,
+		max: 20,
+	}
+
+	StreamReadRetryIntervalValidator = Int64RangeValidator{
+		min: 1,
+		max: 60,
+	}
+
+	StreamDeleteTimeoutValidator = Int64RangeValidator{
+		min: 1,
+		max: 3600,
+	}
+
+	StreamDeleteWaitTimeoutValidator = Int64RangeValidator{
+		min: 0,
+		max: 3600,
+	}
+
+	MaxConcurrentRequestsValidator = Int64RangeValidator{
+		min: 1,
+		max: 1000,
+	}
+
+	MaxApiCallsValidator = Int64RangeValidator{
+		min: 1,
+		max: math.MaxInt32,
+	}
+
+	MaxResponseBytesValidator = Int64RangeValidator{
+		min: 1024,
+		max: 1 << 30,
+	}
+
+	HealthcheckTimeoutSecValidator = Int64RangeValidator{
+		min: 1,
+		max: 300,
+	}
+
+	// EndpointRateLimitValidator bounds an endpoint rate limit override.
+	// QuickNode further caps it by the account's plan.
+	EndpointRateLimitValidator = Int64RangeValidator{
+		min: 1,
+		max: math.MaxInt32,
+	}
+
+	RetryOnStatusValidator = ListInt64ElementsValidator{
+		element: Int64RangeValidator{
+			min: 100,
+			max: 599,
+		},
+	}
 )
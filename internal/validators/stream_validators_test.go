@@ -0,0 +1,197 @@
+// Copyright 2025 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validators_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/circlefin/terraform-provider-quicknode/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func validateMap(v validator.Map, value types.Map) *validator.MapResponse {
+	resp := &validator.MapResponse{}
+	v.ValidateMap(context.Background(), validator.MapRequest{
+		Path:        path.Root("headers"),
+		ConfigValue: value,
+	}, resp)
+	return resp
+}
+
+func stringMap(values map[string]string) types.Map {
+	elements := make(map[string]attr.Value, len(values))
+	for k, v := range values {
+		elements[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func TestWebhookHeadersValidator(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		headers     map[string]string
+		expectError bool
+	}{
+		{
+			"permitted header",
+			map[string]string{"Content-Type": "application/json", "X-Api-Key": "abc"},
+			false,
+		},
+		{
+			"reserved header",
+			map[string]string{"Authorization": "Bearer abc"},
+			true,
+		},
+		{
+			"reserved header in different case",
+			map[string]string{"host": "example.com"},
+			true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := validateMap(validators.WebhookHeadersValidator, stringMap(tc.headers))
+			assert.Equal(t, tc.expectError, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestWebhookHeadersValidator_NamesHeader(t *testing.T) {
+	resp := validateMap(validators.WebhookHeadersValidator, stringMap(map[string]string{"content-length": "10"}))
+	if assert.True(t, resp.Diagnostics.HasError()) {
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "content-length")
+	}
+}
+
+func TestWebhookHeadersSizeValidator(t *testing.T) {
+	many := make(map[string]string, validators.MaxWebhookHeaders+1)
+	for i := range validators.MaxWebhookHeaders + 1 {
+		many[fmt.Sprintf("X-Header-%d", i)] = "v"
+	}
+
+	for _, tc := range []struct {
+		name          string
+		headers       map[string]string
+		expectSummary string
+	}{
+		{
+			"within limits",
+			map[string]string{"Content-Type": "application/json", "X-Api-Key": "abc"},
+			"",
+		},
+		{
+			"too many headers",
+			many,
+			"Too many headers",
+		},
+		{
+			"headers too large",
+			map[string]string{"X-Token": strings.Repeat("a", validators.MaxWebhookHeadersBytes)},
+			"Headers too large",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := validateMap(validators.WebhookHeadersSizeValidator, stringMap(tc.headers))
+			if tc.expectSummary == "" {
+				assert.False(t, resp.Diagnostics.HasError())
+				return
+			}
+			if assert.True(t, resp.Diagnostics.HasError()) {
+				assert.Equal(t, tc.expectSummary, resp.Diagnostics.Errors()[0].Summary())
+			}
+		})
+	}
+}
+
+func TestRetryOnStatusValidator(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		codes       []int64
+		expectError bool
+	}{
+		{"valid codes", []int64{408, 425}, false},
+		{"below range", []int64{408, 99}, true},
+		{"above range", []int64{600}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			elements := make([]attr.Value, len(tc.codes))
+			for i, code := range tc.codes {
+				elements[i] = types.Int64Value(code)
+			}
+
+			resp := &validator.ListResponse{}
+			validators.RetryOnStatusValidator.ValidateList(context.Background(), validator.ListRequest{
+				Path:        path.Root("retry_on_status"),
+				ConfigValue: types.ListValueMust(types.Int64Type, elements),
+			}, resp)
+
+			assert.Equal(t, tc.expectError, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestNetworkValidator_IgnoresCase(t *testing.T) {
+	for _, tc := range []struct {
+		network     string
+		expectError bool
+	}{
+		{"ethereum-mainnet", false},
+		{"Ethereum-Mainnet", false},
+		{"Ethereum-Nowhere", true},
+	} {
+		resp := &validator.StringResponse{}
+		validators.NetworkValidator.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("network"),
+			ConfigValue: types.StringValue(tc.network),
+		}, resp)
+
+		assert.Equal(t, tc.expectError, resp.Diagnostics.HasError(), tc.network)
+	}
+}
+
+func TestNameValidators(t *testing.T) {
+	for _, v := range []validators.StringRegexpValidator{validators.StreamNameValidator, validators.EndpointLabelValidator} {
+		for _, tc := range []struct {
+			name        string
+			value       string
+			expectError bool
+		}{
+			{"plain name", "My Stream", false},
+			{"punctuation and unicode", "eth-mainnet_blocks (prod) – ü", false},
+			{"long name", strings.Repeat("ü", 1000), false},
+			{"empty", "", true},
+			{"newline", "my\nstream", true},
+			{"tab", "my\tstream", true},
+			{"nul", "my\x00stream", true},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				resp := &validator.StringResponse{}
+				v.ValidateString(context.Background(), validator.StringRequest{
+					Path:        path.Root("name"),
+					ConfigValue: types.StringValue(tc.value),
+				}, resp)
+				assert.Equal(t, tc.expectError, resp.Diagnostics.HasError())
+			})
+		}
+	}
+}